@@ -0,0 +1,107 @@
+package as3935go
+
+import (
+	"fmt"
+	"time"
+)
+
+// The counter of the pulses on the IRQ pin, for example a hardware pulse counter of the board.
+type PulseCounter interface {
+	// Count the rising edges on the pin during the window.
+	Count(window time.Duration) (uint64, error)
+}
+
+// Create a software pulse counter counting the edges of the GPIO pin one by one with WaitForEdge. The pin
+// must be configured to detect the rising edges.
+func NewEdgeCounter(pin GPIO) PulseCounter {
+	return &edgeCounter{
+		pin:   pin,
+		clock: systemClock{},
+	}
+}
+
+// The counter measures the window with the clock, which is replaced with the module clock by MeasureAntennaFrequency.
+type edgeCounter struct {
+	pin   GPIO
+	clock Clock
+}
+
+func (c *edgeCounter) Count(window time.Duration) (uint64, error) {
+	var (
+		pulses   uint64 = 0
+		deadline        = c.clock.Now().Add(window)
+	)
+
+	for remaining := window; remaining > 0; remaining = deadline.Sub(c.clock.Now()) {
+		if c.pin.WaitForEdge(remaining) {
+			pulses += 1
+		}
+	}
+
+	return pulses, nil
+}
+
+// The result of the antenna resonance frequency measurement.
+type AntennaMeasurement struct {
+	// The number of the IRQ pin edges counted during the measurement window.
+	Pulses uint64
+
+	// The division ratio of the antenna frequency displayed on the IRQ pin.
+	Division FrequencyDivision
+
+	// The duration of the measurement window.
+	Window time.Duration
+
+	// The antenna resonance frequency in Hz, computed from the counted pulses and the division ratio.
+	FrequencyHz float64
+}
+
+// The antenna frequency is displayed on the IRQ pin via the DISP_LCO bit for the measurement window and the
+// pulses are counted. The module lock is held for the whole measurement. The antenna should resonate at 500kHz,
+// so even with the FrequencyDiv128 division the pin toggles at about 3.9kHz, which requires an accurate
+// edge detection of the software counter created with NewEdgeCounter or a hardware counter.
+func (m *module) MeasureAntennaFrequency(counter PulseCounter, division FrequencyDivision, window time.Duration) (AntennaMeasurement, error) {
+	if counter == nil {
+		return AntennaMeasurement{}, fmt.Errorf("as3935: invalid pulse counter specified")
+	}
+
+	if window <= 0 {
+		return AntennaMeasurement{}, fmt.Errorf("as3935: the measurement window must be positive: %w", ErrValueOutOfRange)
+	}
+
+	// NOTE: The software counter is copied, so the counter passed by the caller is not modified
+	if edges, ok := counter.(*edgeCounter); ok {
+		counter = &edgeCounter{
+			pin:   edges.pin,
+			clock: m.options.clock,
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.setFrequencyDivision(division); err != nil {
+		return AntennaMeasurement{}, err
+	}
+
+	if err := m.i2c.RegWriteMasked(RegisterTuning, uint8(LCO), uint8(LCO)); err != nil {
+		return AntennaMeasurement{}, fmt.Errorf("as3935: failed to display the antenna frequency on the irq pin: %w", err)
+	}
+
+	pulses, countErr := counter.Count(window)
+
+	if err := m.i2c.RegWriteMasked(RegisterTuning, 0x00, uint8(LCO)); err != nil {
+		return AntennaMeasurement{}, fmt.Errorf("as3935: failed to stop displaying the antenna frequency on the irq pin: %w", err)
+	}
+
+	if countErr != nil {
+		return AntennaMeasurement{}, fmt.Errorf("as3935: failed to count the antenna frequency pulses: %w", countErr)
+	}
+
+	return AntennaMeasurement{
+		Pulses:      pulses,
+		Division:    division,
+		Window:      window,
+		FrequencyHz: float64(pulses) / window.Seconds() * float64(division.Ratio()),
+	}, nil
+}
@@ -0,0 +1,100 @@
+package as3935go
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// The minimum approach or recede rate in kilometers per minute considered as a movement of the storm.
+const arrivalMinRateKmPerMinute float64 = 0.1
+
+// The distance estimation read at the given time.
+type TimedDistance struct {
+	Timestamp  time.Time
+	Estimation DistanceEstimation
+}
+
+// Estimate the time until the storm reaches the module from the series of the timestamped distance
+// estimations. The samples do not have to be ordered and the OutOfRange samples are ignored, the
+// StormOverhead samples are treated as zero kilometers.
+//
+// The approach rate is the least squares slope of the distance over time. The TrendApproaching is returned
+// with the time from the newest sample until the fitted distance reaches zero, which is zero when the storm
+// has already arrived. The TrendReceding and TrendStable are returned with a zero duration. At least two
+// usable samples with different timestamps are required, otherwise the ErrValueOutOfRange error is returned.
+func EstimateArrival(samples []TimedDistance) (time.Duration, Trend, error) {
+	var (
+		origin = time.Time{}
+		latest = time.Time{}
+		count  = 0
+	)
+
+	for _, sample := range samples {
+		if !isArrivalSample(sample) {
+			continue
+		}
+
+		if count == 0 || sample.Timestamp.Before(origin) {
+			origin = sample.Timestamp
+		}
+
+		if count == 0 || sample.Timestamp.After(latest) {
+			latest = sample.Timestamp
+		}
+
+		count += 1
+	}
+
+	if count < 2 || !latest.After(origin) {
+		return 0, TrendStable, fmt.Errorf("as3935: at least two distances with different timestamps are required: %w", ErrValueOutOfRange)
+	}
+
+	var (
+		sumX, sumY           = 0.0, 0.0
+		sumXY, sumXX         = 0.0, 0.0
+		n            float64 = float64(count)
+	)
+
+	for _, sample := range samples {
+		if !isArrivalSample(sample) {
+			continue
+		}
+
+		x := sample.Timestamp.Sub(origin).Minutes()
+		y := float64(sample.Estimation.Km)
+		if sample.Estimation.Kind == StormOverhead {
+			y = 0
+		}
+
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+
+	var (
+		slope     = (n*sumXY - sumX*sumY) / (n*sumXX - sumX*sumX)
+		intercept = (sumY - slope*sumX) / n
+	)
+
+	switch {
+	case slope >= arrivalMinRateKmPerMinute:
+		return 0, TrendReceding, nil
+	case slope > -arrivalMinRateKmPerMinute:
+		return 0, TrendStable, nil
+	}
+
+	distance := intercept + slope*latest.Sub(origin).Minutes()
+	if distance <= 0 {
+		return 0, TrendApproaching, nil
+	}
+
+	minutes := distance / -slope
+	return time.Duration(math.Round(minutes * float64(time.Minute))), TrendApproaching, nil
+}
+
+// Check if the sample can be used for the arrival estimation.
+func isArrivalSample(sample TimedDistance) bool {
+	return sample.Estimation.Kind == Estimated || sample.Estimation.Kind == StormOverhead
+}
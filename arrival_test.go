@@ -0,0 +1,75 @@
+package as3935go
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// Create the series of the estimated distances sampled every interval starting at the Unix epoch.
+func timedDistances(interval time.Duration, kms ...int) []TimedDistance {
+	samples := make([]TimedDistance, 0, len(kms))
+	for index, km := range kms {
+		samples = append(samples, TimedDistance{
+			Timestamp:  time.Unix(0, 0).Add(time.Duration(index) * interval),
+			Estimation: DistanceEstimation{Kind: Estimated, Km: km},
+		})
+	}
+
+	return samples
+}
+
+func TestEstimateArrival(t *testing.T) {
+	overhead := append(timedDistances(5*time.Minute, 10, 5), TimedDistance{
+		Timestamp:  time.Unix(0, 0).Add(10 * time.Minute),
+		Estimation: DistanceEstimation{Kind: StormOverhead},
+	})
+
+	outOfRange := append(timedDistances(5*time.Minute, 40, 30, 20), TimedDistance{
+		Timestamp:  time.Unix(0, 0).Add(15 * time.Minute),
+		Estimation: DistanceEstimation{Kind: OutOfRange},
+	})
+
+	unordered := timedDistances(5*time.Minute, 40, 30, 20)
+	unordered[0], unordered[2] = unordered[2], unordered[0]
+
+	cases := []struct {
+		name    string
+		samples []TimedDistance
+		arrival time.Duration
+		trend   Trend
+	}{
+		{"approaching", timedDistances(5*time.Minute, 40, 30, 20), 10 * time.Minute, TrendApproaching},
+		{"unordered", unordered, 10 * time.Minute, TrendApproaching},
+		{"out of range ignored", outOfRange, 10 * time.Minute, TrendApproaching},
+		{"overhead", overhead, 0, TrendApproaching},
+		{"receding", timedDistances(5*time.Minute, 10, 20, 30), 0, TrendReceding},
+		{"stable", timedDistances(5*time.Minute, 20, 20, 20), 0, TrendStable},
+	}
+
+	for _, c := range cases {
+		arrival, trend, err := EstimateArrival(c.samples)
+		if err != nil {
+			t.Fatalf("%s: failed to estimate the arrival: %s", c.name, err)
+		}
+
+		if trend != c.trend || arrival != c.arrival {
+			t.Fatalf("%s: expected %s in %s, got %s in %s", c.name, c.trend, c.arrival, trend, arrival)
+		}
+	}
+}
+
+func TestEstimateArrivalInsufficientSamples(t *testing.T) {
+	sameTimestamp := timedDistances(0, 30, 20)
+
+	outOfRange := []TimedDistance{
+		{Timestamp: time.Unix(0, 0), Estimation: DistanceEstimation{Kind: OutOfRange}},
+		{Timestamp: time.Unix(60, 0), Estimation: DistanceEstimation{Kind: OutOfRange}},
+	}
+
+	for _, samples := range [][]TimedDistance{nil, timedDistances(time.Minute, 20), sameTimestamp, outOfRange} {
+		if _, _, err := EstimateArrival(samples); !errors.Is(err, ErrValueOutOfRange) {
+			t.Fatalf("expected the value out of range error for %v, got %v", samples, err)
+		}
+	}
+}
@@ -1,10 +1,12 @@
 package as3935go
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/Krzysztofz01/as3935-go/internal"
@@ -26,15 +28,44 @@ const (
 	NoiseLevelTooHigh  InterruptType = 0x01
 	DisturberDetected  InterruptType = 0x04
 	LightningInterrupt InterruptType = 0x08
+
+	// The INT register value not defined by the datasheet. The value is outside of the 4-bit INT field, the
+	// raw register value can be retrieved together with the type via GetInterruptSourceRaw.
+	InterruptUnknown InterruptType = 0x10
 )
 
-type TuningCapacitance uint16
+type TuningCapacitance uint8
+
+const (
+	Tuning0pF   TuningCapacitance = 0x00
+	Tuning8pF   TuningCapacitance = 0x01
+	Tuning16pF  TuningCapacitance = 0x02
+	Tuning24pF  TuningCapacitance = 0x03
+	Tuning32pF  TuningCapacitance = 0x04
+	Tuning40pF  TuningCapacitance = 0x05
+	Tuning48pF  TuningCapacitance = 0x06
+	Tuning56pF  TuningCapacitance = 0x07
+	Tuning64pF  TuningCapacitance = 0x08
+	Tuning72pF  TuningCapacitance = 0x09
+	Tuning80pF  TuningCapacitance = 0x0A
+	Tuning88pF  TuningCapacitance = 0x0B
+	Tuning96pF  TuningCapacitance = 0x0C
+	Tuning104pF TuningCapacitance = 0x0D
+	Tuning112pF TuningCapacitance = 0x0E
+	Tuning120pF TuningCapacitance = 0x0F
+)
 
+// The previous presets did not correspond to the 4-bit TUN_CAP register. They are kept with the
+// values that were effectively written to the register.
 const (
-	TuningDiv16  TuningCapacitance = 0x0000
-	TuningDiv32  TuningCapacitance = 0x000F
-	TuningDiv64  TuningCapacitance = 0x0F00
-	TuningDiv128 TuningCapacitance = 0x0F0F
+	// Deprecated: Use Tuning0pF instead.
+	TuningDiv16 TuningCapacitance = Tuning0pF
+	// Deprecated: Use Tuning120pF instead.
+	TuningDiv32 TuningCapacitance = Tuning120pF
+	// Deprecated: Use Tuning0pF instead.
+	TuningDiv64 TuningCapacitance = Tuning0pF
+	// Deprecated: Use Tuning120pF instead.
+	TuningDiv128 TuningCapacitance = Tuning120pF
 )
 
 type AnalogFrontEnd uint8
@@ -101,14 +132,75 @@ const (
 	SREJ11 SpikeRejection = 0x0B
 )
 
+type MinNumberOfLightning uint8
+
+const (
+	MinLightning1  MinNumberOfLightning = 0x00
+	MinLightning5  MinNumberOfLightning = 0x10
+	MinLightning9  MinNumberOfLightning = 0x20
+	MinLightning16 MinNumberOfLightning = 0x30
+)
+
+// Get the number of lightning events corresponding to the MIN_NUM_LIGH field value. The field encoding is
+// 00 -> 1, 01 -> 5, 10 -> 9 and 11 -> 16.
+func (n MinNumberOfLightning) Count() uint8 {
+	return [4]uint8{1, 5, 9, 16}[n>>4&0x03]
+}
+
+type FrequencyDivision uint8
+
+const (
+	FrequencyDiv16  FrequencyDivision = 0x00
+	FrequencyDiv32  FrequencyDivision = 0x40
+	FrequencyDiv64  FrequencyDivision = 0x80
+	FrequencyDiv128 FrequencyDivision = 0xC0
+)
+
+// Get the division ratio corresponding to the LCO_FDIV field value.
+func (d FrequencyDivision) Ratio() int {
+	return 16 << (d >> 6 & 0x03)
+}
+
+// The state of the TRCO and SRCO oscillators calibration stored in the TRCO_CALIB and SRCO_CALIB registers.
+type CalibrationStatus struct {
+	TRCODone bool
+	TRCONok  bool
+	SRCODone bool
+	SRCONok  bool
+}
+
+// A single reading of the module state after an interrupt. The distance and energy are only populated
+// for the LightningInterrupt interrupt type.
+type StrikeEvent struct {
+	Type       InterruptType
+	DistanceKm int
+	Energy     float64
+	Timestamp  time.Time
+}
+
+const (
+	kilometersToMiles         float64 = 0.621371
+	kilometersToNauticalMiles float64 = 0.539957
+)
+
 // The documentation says about 2ms delays after certain operations. The library takes
-// three additional ms to be extra sure about the applied changes.
-const delayDuration = time.Duration(5) * time.Millisecond
+// three additional ms to be extra sure about the applied changes. The delay can be changed
+// with the WithSettleDelay option. The methods documented as awaiting the settle delay incur it.
+const DefaultSettleDelay = time.Duration(5) * time.Millisecond
+
+// The minimum interval between the register reads of the polling helpers, which prevents the loops with a
+// short polling interval from saturating the i2c bus. The interval can be changed with the WithMinPollInterval option.
+const DefaultMinPollInterval = time.Duration(10) * time.Millisecond
 
 type Module interface {
-	// Open the communication with the module over i2c.
+	// Open the communication with the module over i2c and apply the register options. The connection is
+	// closed if applying the register options fails.
 	Open() error
 
+	// Open the communication with the module over i2c and apply the register options. The context allows to
+	// abandon the opening of a hung bus, the ctx.Err() is returned in that case.
+	OpenContext(ctx context.Context) error
+
 	// Close the communication over i2c with the module.
 	Close() error
 
@@ -127,14 +219,21 @@ type Module interface {
 	// Set the internal capacitors capacitance in range from 0pF - 120pF via TUN_CAP register.
 	SetTuningCapacitance(capacitance TuningCapacitance) error
 
-	// Get the interrupt source type via the INT register.
+	// Get the interrupt source type via the INT register. The type is the low nibble of the GetInterruptRaw
+	// value, the values not defined by the datasheet are reported as the InterruptUnknown unless the
+	// WithStrictInterrupt option is set. The settle delay is awaited before the read.
 	GetInterruptSource() (InterruptType, error)
 
+	// Get the interrupt source type via the INT register. The settle delay is awaited before the read and the
+	// context allows to cancel it.
+	GetInterruptSourceContext(ctx context.Context) (InterruptType, error)
+
 	// Get estimated distance in KM of storm/latest lightning via the DISTANCE register. The value
 	// "0" corresponds to "Storm ahead" and the "math.MaxInt" correspondes to "Out of range".
 	GetLightningDistanceKm() (int, error)
 
-	// Get the lightning strike energy via the S_LIG_MM/S_LIG_M/S_LIG_L registers.
+	// Get the lightning strike energy via the S_LIG_MM/S_LIG_M/S_LIG_L registers. The value is a pure number
+	// without a physical unit, normalized from the raw 21-bit value by 2^24 into the range from 0.0 to 0.125.
 	GetStrikeEnergy() (float64, error)
 
 	// Set the environment tuning via the AFE_GB register.
@@ -161,56 +260,820 @@ type Module interface {
 	// Set the spike rejection which controls the behavior of disturbers via the SREJ register.
 	SetSpikeRejection(rejection SpikeRejection) error
 
-	// Set the power up or down via the PWD register.
+	// Set the power up or down via the PWD register. The power up sends the PRESET_DEFAULT and CALIB_RCO direct
+	// commands and the ErrCalibrationFailed error is returned when the oscillators calibration is not reported
+	// as done or is reported as not successful. The settle delay is awaited once during the power up.
 	PowerSwitch(power bool) error
+
+	// Set the power up or down via the PWD register. The settle delay is awaited once during the power up and
+	// the context allows to cancel it.
+	PowerSwitchContext(ctx context.Context, power bool) error
+
+	// Get the minimum number of lightning events (1, 5, 9 or 16) in the last 15 minutes required to trigger an interrupt via the MIN_NUM_LIGH register.
+	GetMinNumberOfLightning() (uint8, error)
+
+	// Get the raw 2-bit value of the MIN_NUM_LIGH register, which encodes the minimum number of lightning events.
+	GetMinNumberOfLightningRaw() (uint8, error)
+
+	// Set the minimum number of lightning events in the last 15 minutes required to trigger an interrupt via the MIN_NUM_LIGH register.
+	SetMinNumberOfLightning(minimum MinNumberOfLightning) error
+
+	// Clear the lightning distance estimation statistics by toggling the CL_STAT register high-low-high. The
+	// settle delay is awaited twice.
+	ClearStatistics() error
+
+	// Get the division ratio of the antenna frequency displayed on the IRQ pin via the LCO_FDIV register. The
+	// MASK_DIST bit sharing the register is not part of the returned value.
+	GetFrequencyDivision() (FrequencyDivision, error)
+
+	// Set the division ratio of the antenna frequency displayed on the IRQ pin via the LCO_FDIV register. Only
+	// the LCO_FDIV bits are written, so the MASK_DIST bit sharing the register is preserved.
+	SetFrequencyDivision(division FrequencyDivision) error
+
+	// Calibrate the internal RC oscillators via the CALIB_RCO direct command register. The settle delay is awaited twice.
+	CalibrateRCO() error
+
+	// Calibrate the internal RC oscillators via the CALIB_RCO direct command register. The context allows to
+	// cancel the calibration sequence delays. The settle delay is awaited twice.
+	CalibrateRCOContext(ctx context.Context) error
+
+	// Get the state of the oscillators calibration via the TRCO_CALIB_DONE/TRCO_CALIB_NOK/SRCO_CALIB_DONE/SRCO_CALIB_NOK registers.
+	GetCalibrationStatus() (CalibrationStatus, error)
+
+	// Get the internal capacitors capacitance in pF (0pF - 120pF in 8pF steps) via TUN_CAP register.
+	GetTuningCapacitancePF() (uint8, error)
+
+	// Set the internal capacitors capacitance in pF via TUN_CAP register. The value in range from 0pF - 120pF
+	// is rounded to the nearest 8pF step.
+	SetTuningCapacitancePF(pf uint8) error
+
+	// Get the environment tuning via the AFE_GB register.
+	GetAnalogFrontEnd() (AnalogFrontEnd, error)
+
+	// Get the internal capacitors capacitance in range from 0pF - 120pF via TUN_CAP register.
+	GetTuningCapacitance() (TuningCapacitance, error)
+
+	// Get the source type of the IRQ pin interrupt via the DISP_LCO/DISP_SRCO/DISP_TRCO registers.
+	GetIRQOutputSource() (IRQOutputSource, error)
+
+	// Check if the disturber is masked via the MASK_DIST register.
+	IsDisturberMasked() (bool, error)
+
+	// Check if the CL_STAT bit is set. The bit idles high, it is low only in the middle of the ClearStatistics sequence.
+	IsStatisticsCleared() (bool, error)
+
+	// Check if the module is powered up via the PWD register.
+	IsPoweredUp() (bool, error)
+
+	// Read the interrupt source and, in case of a lightning, the distance and strike energy as one coherent
+	// event. The settle delay is awaited before the read.
+	ReadStrikeEvent() (StrikeEvent, error)
+
+	// Get estimated distance in miles of storm/latest lightning via the DISTANCE register. The value
+	// "0" corresponds to "Storm ahead" and the "math.Inf(1)" correspondes to "Out of range".
+	GetLightningDistanceMiles() (float64, error)
+
+	// Get estimated distance in nautical miles of storm/latest lightning via the DISTANCE register. The value
+	// "0" corresponds to "Storm ahead" and the "math.Inf(1)" correspondes to "Out of range".
+	GetLightningDistanceNauticalMiles() (float64, error)
+
+	// Get the uncalibrated 21-bit lightning strike energy via the S_LIG_MM/S_LIG_M/S_LIG_L registers.
+	// The value is a pure number without a physical unit.
+	GetStrikeEnergyRaw() (uint32, error)
+
+	// Watch the IRQ pin for interrupts and emit the strike events read after each edge. All interrupt types
+	// except NoResults are emitted. The channel is closed when the context is done.
+	Watch(ctx context.Context, irqPin GPIO) (<-chan StrikeEvent, error)
+
+	// Apply the whole configuration to the registers. All the fields are validated before the first write and
+	// are written under a single lock.
+	ApplyConfig(cfg Config) error
+
+	// Read the whole configuration from a single dump of the registers.
+	ReadConfig() (Config, error)
+
+	// Dump the value of registers from 0x00 to 0x08 and decode them into the individual fields.
+	DumpRegistersDecoded() (RegisterDump, error)
+
+	// Log the state of the registers on register reads and writes into the provided writer. The nil
+	// writer disables the logging.
+	SetDebugOutput(debugOut io.Writer)
+
+	// Reset the module to the power-up default state via the PRESET_DEFAULT and CALIB_RCO direct command
+	// registers followed by the TRCO display on the IRQ pin.
+	Reset() error
+
+	// Get the estimated distance of storm/latest lightning via the DISTANCE register with the "Storm ahead"
+	// and "Out of range" cases represented explicitly by the estimation kind.
+	GetLightningEstimation() (DistanceEstimation, error)
+
+	// Read the raw value of the register at the given offset in range from 0x00 to 0x3F.
+	ReadRegister(offset uint8) (uint8, error)
+
+	// Write the raw value to the register at the given offset in range from 0x00 to 0x3F.
+	WriteRegister(offset, value uint8) error
+
+	// Check if the AS3935 sensor is responding at the configured address. The registers are checked for
+	// plausible values and the TUN_CAP register is round-tripped and restored. The module must be opened.
+	Probe() error
+
+	// Adapt the noise floor level to the ambient noise. The level is raised on each NoiseLevelTooHigh
+	// interrupt and lowered back, but not below the initial level, after a quiet period. Both AFE modes
+	// share the same eight NF_LEV steps. Each applied level is emitted on the channel, which must be
+	// drained by the caller and is closed when the context is done. The loop reads the interrupt register,
+	// so it should not be combined with other interrupt readers.
+	AutoAdjustNoiseFloor(ctx context.Context) (<-chan NoiseFloorLevel, error)
+
+	// Get the noise floor threshold in µVrms via the NF_LEV register interpreted for the AFE_GB mode.
+	GetNoiseFloorMicroVrms() (uint32, error)
+
+	// Get both the noise floor level and the watchdog threshold via a single read of the NF_LEV/WDTH register.
+	// The raw values are returned without the validation.
+	GetRegister01() (noiseFloor uint8, watchdog uint8, err error)
+
+	// Capture the writable configuration registers into a versioned snapshot. The PWD and CL_STAT fields
+	// are not captured.
+	SnapshotState() ([]byte, error)
+
+	// Restore the configuration registers from the snapshot created with SnapshotState. The snapshot
+	// version and length are validated before any register is written.
+	RestoreState(b []byte) error
+
+	// Register the handler called with the strike events read by the interrupt handling started with Start.
+	// The handler is called without the module lock held, so it can call the module methods.
+	OnInterrupt(handler func(StrikeEvent))
+
+	// Register the handler called with the read errors of the interrupt handling started with Start.
+	OnError(handler func(error))
+
+	// Start the background interrupt handling which waits for the IRQ pin edges and calls the registered
+	// handlers until the context is done or Stop is called. The ErrAlreadyStarted error is returned while the
+	// handling is running, afterwards the handling can be started again.
+	Start(ctx context.Context, irqPin GPIO) error
+
+	// Stop the background interrupt handling and wait until it exits. It must not be called from the handlers.
+	Stop()
+
+	// Poll the interrupt register at the given interval until an interrupt other than NoResults is reported
+	// or the context is done. It is an alternative to Watch for setups without the IRQ pin connected.
+	WaitForInterrupt(ctx context.Context, poll time.Duration) (InterruptType, error)
+
+	// Perform multiple register writes while holding the module lock. The writes are staged and committed
+	// only if the function returns nil.
+	Transaction(fn func(tx RegTx) error) error
+
+	// Dump the value of registers from 0x00 to 0x08 and format them as a labeled text with one field per line.
+	DumpRegistersString() (string, error)
+
+	// Get the raw value of the INT register including the LCO_FDIV and MASK_DIST bits. The read is performed
+	// after the delay required by the interrupt register.
+	GetInterruptRaw() (uint8, error)
+
+	// Get the interrupt source type via the INT register without the delay before the read. The caller is
+	// responsible for waiting at least 2ms after the IRQ pin goes high, otherwise the value can be invalid.
+	GetInterruptSourceNow() (InterruptType, error)
+
+	// Close the connection ignoring the errors and open it again, to recover from a broken bus connection.
+	// The register options and the last state snapshot are applied again.
+	Reconnect() error
+
+	// Poll the DISTANCE register at the given interval and send the estimation to the channel only when it
+	// changes, including the transitions to and from the storm overhead and out of range kinds. The channel
+	// is closed when the context is done.
+	WatchDistance(ctx context.Context, poll time.Duration) (<-chan DistanceEstimation, error)
+
+	// Capture the configuration registers and power down the module via the PWD register.
+	Suspend() error
+
+	// Power up the module and restore the configuration captured by Suspend. The calibration sequence is
+	// performed only if the recalibration interval set with WithRecalibrationInterval has elapsed.
+	Resume() error
+
+	// Power up the module and restore the configuration captured by Suspend. The context allows to cancel
+	// the calibration sequence delays.
+	ResumeContext(ctx context.Context) error
+
+	// Measure the antenna resonance frequency by counting the IRQ pin pulses while the antenna frequency divided
+	// by the given division is displayed on the pin. The GPIO pin can be counted with the NewEdgeCounter counter.
+	MeasureAntennaFrequency(counter PulseCounter, division FrequencyDivision, window time.Duration) (AntennaMeasurement, error)
+
+	// Perform the factory self-test of the module, which powers up and calibrates the module, round-trips
+	// every writable field and verifies the PRESET_DEFAULT defaults. The module is left in the default state.
+	SelfTest() (SelfTestReport, error)
+
+	// Read the strike event and return it only if it is a lightning with the energy of at least minEnergy,
+	// the scale of the energy is the same as for GetStrikeEnergy. Nil is returned for the other events. The
+	// settle delay is awaited before the read.
+	ReadLightningAbove(minEnergy float64) (*StrikeEvent, error)
+
+	// Get the heuristic classification of the chip detected by DetectVariant. The VariantUnknown is returned
+	// if DetectVariant has not been called successfully.
+	Variant() ChipVariant
+
+	// Detect the heuristic classification of the chip via the PRESET_DEFAULT direct command and the comparison
+	// of the settled registers with the defaults. The detection is destructive: the pending interrupt, the
+	// distance and the energy registers are reset and can not be restored. The oscillators are calibrated
+	// again the same way as by CalibrateRCO and the configuration and the PWD bit are restored afterwards.
+	DetectVariant() (ChipVariant, error)
+
+	// Set the environment tuning via the AFE_GB register and return the previous value. The read and the
+	// write are performed under a single lock.
+	SetAnalogFrontEndWithPrev(model AnalogFrontEnd) (AnalogFrontEnd, error)
+
+	// Set the noise floor level via the NF_LEV register and return the previous value. The read and the
+	// write are performed under a single lock.
+	SetNoiseFloorLevelWithPrev(level NoiseFloorLevel) (NoiseFloorLevel, error)
+
+	// Set the watchdog threshold via the WDTH register and return the previous value. The read and the
+	// write are performed under a single lock.
+	SetWatchdogThresholdWithPrev(threshold WatchdogThreshold) (WatchdogThreshold, error)
+
+	// Set the spike rejection via the SREJ register and return the previous value. The read and the
+	// write are performed under a single lock.
+	SetSpikeRejectionWithPrev(rejection SpikeRejection) (SpikeRejection, error)
+
+	// Poll the interrupt register at the given interval and write every interrupt other than NoResults as
+	// a line with the timestamp, including the distance and energy of the lightning, until the context is done.
+	LogInterrupts(ctx context.Context, w io.Writer, poll time.Duration) error
+
+	// Find the lowest SREJ and WDTH combination keeping the rate of the disturbers under the target while
+	// not suppressing the lightning. The original values are restored if the context is done.
+	OptimizeDisturberRejection(ctx context.Context, target DisturberProfile) (DisturberRejectionResult, error)
+
+	// Get the raw values of the S_LIG_L, S_LIG_M and S_LIG_MM registers read under a single lock.
+	GetStrikeEnergyBytes() (l, mid, mm uint8, err error)
+
+	// Read the DISTANCE register repeatedly until the estimation stays the same for the settle duration,
+	// to avoid the jitter of the estimation updated right after the strike. The context error is returned
+	// when the estimation does not settle before the context is done.
+	ReadStableDistance(ctx context.Context, settle time.Duration) (DistanceEstimation, error)
+
+	// Read the registers from 0x00 to 0x08 and compare the configuration fields with the power-up defaults
+	// documented in the datasheet, to confirm that the PRESET_DEFAULT direct command took effect. The offsets
+	// of the mismatching registers are returned.
+	VerifyDefaults() (bool, []uint8, error)
+
+	// Set the WDTH, SREJ and MIN_NUM_LIGH fields governing the disturber rejection under a single lock. Nothing
+	// is written if any of the values is out of range.
+	SetDisturberTuning(threshold WatchdogThreshold, rejection SpikeRejection, minimum MinNumberOfLightning) error
+
+	// Set the analog front end and the noise floor level interpreted in the µVrms scale of that environment,
+	// use the Indoor*MicroVrms constants with Indoor and the Outdoor*MicroVrms constants with Outdoor.
+	SetNoiseFloorForEnvironment(afe AnalogFrontEnd, level NoiseFloorLevel) error
+
+	// Get the path of the i2c device the module was created with. For the modules created with a custom
+	// transport the result of the transport String method is returned, or an empty path if it is not implemented.
+	DevicePath() string
+
+	// Get the i2c address of the module.
+	Address() int
+
+	// Power down the module preserving the configuration like Suspend and close the i2c connection, which
+	// allows to park the hardware with a deferred call.
+	Shutdown(ctx context.Context) error
+
+	// Get the raw 6-bit value of the DISTANCE register. The 0x01 code means the storm is overhead and the
+	// 0x3F code means the storm is out of range, the other codes are the estimated distance in km, which is
+	// what GetLightningDistanceKm is decoding with the datasheet lookup table.
+	GetLightningDistanceRaw() (uint8, error)
+
+	// Get the interrupt source type together with the raw value of the INT register from a single read, which
+	// allows to inspect the values reported as the InterruptUnknown. The settle delay is awaited before the read.
+	GetInterruptSourceRaw() (InterruptType, uint8, error)
 }
 
+// The module is closed via the Close method of the io.Closer contract.
+var _ io.Closer = (Module)(nil)
+
+// The range of the I2C addresses supported by the module, selected by the ADD0 and ADD1 pins.
+const (
+	MinAddress int = 0x00
+	MaxAddress int = 0x03
+)
+
 // Create a instance of the AS3935 module from the provided device path and I2C address.
-// All module functions are locking what allows to use the module in multiple goroutines.
-func NewModule(device string, address int) (Module, error) {
-	i2c, err := internal.NewI2cDevice(device, address, nil)
+// All module functions are locking what allows to use the module in multiple goroutines. The getters
+// are sharing a read lock, so they are not serialized behind each other.
+// The options are applied to the module, the register options are applied on Open.
+func NewModule(device string, address int, opts ...Option) (Module, error) {
+	if address < MinAddress || address > MaxAddress {
+		return nil, fmt.Errorf("as3935: the i2c address 0x%02x is not supported by the module: %w", address, ErrValueOutOfRange)
+	}
+
+	options := newOptions(opts)
+
+	i2c, err := internal.NewI2cDevice(device, address, options.readStrategy == BulkRead)
 	if err != nil {
 		return nil, fmt.Errorf("as3935: failed to create the i2c device representation: %w", err)
 	}
 
-	return &module{
-		i2c: i2c,
-		mu:  sync.Mutex{},
-	}, nil
+	m := newModule(i2c, address, options)
+	m.device = device
+	return m, nil
 }
 
 // Create a instance of the AS3935 module from the provided device path and I2C address.
 // All module functions are locking what allows to use the module in multiple goroutines.
 // The I2C reads and writes are logging the state of the registers into teh debougOut pipe.
 func NewModuleDebug(device string, address int, debugOut io.Writer) (Module, error) {
-	i2c, err := internal.NewI2cDevice(device, address, debugOut)
+	return NewModule(device, address, WithDebugOutput(debugOut))
+}
+
+// Create a instance of the AS3935 module communicating via the provided transport. The address is the
+// address of the module the transport is communicating with. The transports implementing fmt.Stringer
+// are reporting the device path via the String method.
+// All module functions are locking what allows to use the module in multiple goroutines.
+// The options are applied to the module, the register options are applied on Open.
+func NewModuleWithTransport(transport Transport, address int, opts ...Option) (Module, error) {
+	if transport == nil {
+		return nil, fmt.Errorf("as3935: invalid transport specified")
+	}
+
+	if address < 0 {
+		return nil, fmt.Errorf("as3935: invalid i2c address specified: %w", ErrValueOutOfRange)
+	}
+
+	m := newModule(transport, address, newOptions(opts))
+	if stringer, ok := transport.(fmt.Stringer); ok {
+		m.device = stringer.String()
+	}
+
+	return m, nil
+}
+
+// Wait for the given duration or until the context is done. The context error is returned if the
+// context is done before the duration elapsed. A custom clock can not be interrupted, so the context is
+// only checked after its sleep.
+func (m *module) sleepContext(ctx context.Context, duration time.Duration) error {
+	if duration <= 0 {
+		return ctx.Err()
+	}
+
+	if _, ok := m.options.clock.(systemClock); !ok {
+		m.options.clock.Sleep(duration)
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func newModule(transport internal.I2c, address int, options options) *module {
+	m := &module{
+		transport: transport,
+		address:   address,
+		options:   options,
+		mu:        &sync.RWMutex{},
+	}
+
+	if options.withoutLocking {
+		m.mu = noopLocker{}
+	}
+
+	if options.statistics != nil {
+		options.statistics.setClock(options.clock)
+	}
+
+	m.decorateTransport()
+	return m
+}
+
+type module struct {
+	i2c          internal.I2c
+	transport    internal.I2c
+	device       string
+	address      int
+	options      options
+	callbacks    callbacks
+	snapshot     []byte
+	calibratedAt time.Time
+	variant      ChipVariant
+	mu           locker
+
+	// The interrupt type decoded by the last INT register read. The register is cleared on read, so the
+	// strict mode checks the cached type. The value is atomic, because the reads hold the shared lock only.
+	lastInterrupt atomic.Uint32
+
+	// The number of the Open, Reconnect and Close calls. The abandoned opening closes the connection only if
+	// the connection was not opened or closed again since.
+	generation uint64
+
+	// The time of the last register read of the polling helpers, shared by the helpers running at once.
+	lastPoll time.Time
+	pollMu   sync.Mutex
+}
+
+// The lock of the module, which is the sync.RWMutex unless the locking is disabled with WithoutLocking.
+type locker interface {
+	sync.Locker
+	RLock()
+	RUnlock()
+}
+
+// The locker which does nothing, used when the locking is disabled with WithoutLocking.
+type noopLocker struct{}
+
+func (noopLocker) Lock()    {}
+func (noopLocker) Unlock()  {}
+func (noopLocker) RLock()   {}
+func (noopLocker) RUnlock() {}
+
+func (m *module) ReadStrikeEvent() (StrikeEvent, error) {
+	return m.readStrikeEvent(context.Background())
+}
+
+func (m *module) ReadLightningAbove(minEnergy float64) (*StrikeEvent, error) {
+	event, err := m.readStrikeEvent(context.Background())
 	if err != nil {
-		return nil, fmt.Errorf("as3935: failed to create the i2c device representation: %w", err)
+		return nil, err
+	}
+
+	if event.Type != LightningInterrupt || event.Energy < minEnergy {
+		return nil, nil
+	}
+
+	return &event, nil
+}
+
+// Read the strike event after the interrupt register delay. The delay is awaited before the lock is
+// acquired, so concurrent calls are not serialized behind the sleep.
+func (m *module) readStrikeEvent(ctx context.Context) (StrikeEvent, error) {
+	if err := m.sleepContext(ctx, m.options.settleDelay); err != nil {
+		return StrikeEvent{}, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	interrupt, err := m.getInterruptSource()
+	if err != nil {
+		return StrikeEvent{}, fmt.Errorf("as3935: failed to read the strike event interrupt source: %w", err)
+	}
+
+	event := StrikeEvent{
+		Type:      interrupt,
+		Timestamp: m.options.clock.Now(),
+	}
+
+	if interrupt != LightningInterrupt {
+		return event, nil
+	}
+
+	if event.DistanceKm, err = m.getLightningDistanceKm(); err != nil {
+		return StrikeEvent{}, fmt.Errorf("as3935: failed to read the strike event distance: %w", err)
+	}
+
+	if event.Energy, err = m.getStrikeEnergy(); err != nil {
+		return StrikeEvent{}, fmt.Errorf("as3935: failed to read the strike event energy: %w", err)
+	}
+
+	if m.options.statistics != nil {
+		m.options.statistics.Record(event)
+	}
+
+	if m.options.observer != nil {
+		m.options.observer.ObserveStrike(event)
+	}
+
+	return event, nil
+}
+
+func (m *module) IsPoweredUp() (bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	register, err := m.i2c.RegRead(RegisterPower)
+	if err != nil {
+		return false, fmt.Errorf("as3935: failed to access the power register: %w", err)
+	}
+
+	return register&0x01 == 0, nil
+}
+
+func (m *module) IsDisturberMasked() (bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	register, err := m.i2c.RegRead(RegisterInterrupt)
+	if err != nil {
+		return false, fmt.Errorf("as3935: failed to access the disturber mask register: %w", err)
+	}
+
+	return register&0x20 != 0, nil
+}
+
+func (m *module) IsStatisticsCleared() (bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	register, err := m.i2c.RegRead(RegisterStatistics)
+	if err != nil {
+		return false, fmt.Errorf("as3935: failed to access the clear statistics register: %w", err)
+	}
+
+	return register&0x40 != 0, nil
+}
+
+func (m *module) GetIRQOutputSource() (IRQOutputSource, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	register, err := m.i2c.RegRead(RegisterTuning)
+	if err != nil {
+		return None, fmt.Errorf("as3935: failed to get the irq output source register: %w", err)
+	}
+
+	switch IRQOutputSource(register & 0xE0) {
+	case None:
+		return None, nil
+	case TRCO:
+		return TRCO, nil
+	case SRCO:
+		return SRCO, nil
+	case LCO:
+		return LCO, nil
+	default:
+		return None, fmt.Errorf("as3935: the irq output source had a corrupted value: %w", ErrCorruptedRegister)
+	}
+}
+
+func (m *module) GetTuningCapacitance() (TuningCapacitance, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	register, err := m.i2c.RegRead(RegisterTuning)
+	if err != nil {
+		return Tuning0pF, fmt.Errorf("as3935: failed to get the tuning capacitance register: %w", err)
+	}
+
+	return TuningCapacitance(register & 0x0F), nil
+}
+
+func (m *module) GetAnalogFrontEnd() (AnalogFrontEnd, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	register, err := m.i2c.RegRead(RegisterPower)
+	if err != nil {
+		return 0x00, fmt.Errorf("as3935: failed to get the analog frontend register: %w", err)
+	}
+
+	switch AnalogFrontEnd(register & 0x3E) {
+	case Indoor:
+		return Indoor, nil
+	case Outdoor:
+		return Outdoor, nil
+	default:
+		return 0x00, fmt.Errorf("as3935: the analog frontend had a corrupted value: %w", ErrCorruptedRegister)
+	}
+}
+
+func (m *module) GetTuningCapacitancePF() (uint8, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	register, err := m.i2c.RegRead(RegisterTuning)
+	if err != nil {
+		return 0x00, fmt.Errorf("as3935: failed to get the tuning capacitance register: %w", err)
+	}
+
+	return (register & 0x0F) * 8, nil
+}
+
+func (m *module) SetTuningCapacitancePF(pf uint8) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if pf > 120 {
+		return fmt.Errorf("as3935: the specified tuning capacitance is out of range: %w", ErrValueOutOfRange)
+	}
+
+	if err := m.i2c.RegWriteMasked(RegisterTuning, (pf+4)/8, 0x0F); err != nil {
+		return fmt.Errorf("as3935: failed to apply the tuning capacitance to register: %w", err)
+	}
+
+	return nil
+}
+
+func (m *module) Reset() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ctx := context.Background()
+
+	if err := m.i2c.RegWrite(RegisterPresetDefault, m.options.commandKey); err != nil {
+		return fmt.Errorf("as3935: failed to set value to the preset default direct command register: %w", err)
+	}
+
+	if err := m.sleepContext(ctx, m.options.settleDelay); err != nil {
+		return err
+	}
+
+	if err := m.calibrateRCOContext(ctx); err != nil {
+		return fmt.Errorf("as3935: failed to calibrate the oscillators during the reset: %w", err)
+	}
+
+	if err := m.sleepContext(ctx, m.options.settleDelay); err != nil {
+		return err
 	}
 
-	return &module{
-		i2c: i2c,
-		mu:  sync.Mutex{},
+	return nil
+}
+
+func (m *module) CalibrateRCO() error {
+	return m.CalibrateRCOContext(context.Background())
+}
+
+func (m *module) CalibrateRCOContext(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.calibrateRCOContext(ctx)
+}
+
+func (m *module) calibrateRCOContext(ctx context.Context) error {
+	if err := m.i2c.RegWrite(RegisterCalibrateRCO, m.options.commandKey); err != nil {
+		return fmt.Errorf("as3935: failed to set value to the calibrate rco direct command register: %w", err)
+	}
+
+	if err := m.sleepContext(ctx, m.options.settleDelay); err != nil {
+		return err
+	}
+
+	if err := m.i2c.RegWriteMasked(RegisterTuning, uint8(TRCO), uint8(TRCO)); err != nil {
+		return fmt.Errorf("as3935: failed to set the irq source up as calibration sequence to the register: %w", err)
+	}
+
+	if err := m.sleepContext(ctx, m.options.settleDelay); err != nil {
+		return err
+	}
+
+	if err := m.i2c.RegWriteMasked(RegisterTuning, 0x00, uint8(TRCO)); err != nil {
+		return fmt.Errorf("as3935: failed to set the irq source down as calibration sequence to the register: %w", err)
+	}
+
+	status, err := m.getCalibrationStatus()
+	if err != nil {
+		return fmt.Errorf("as3935: failed to verify the calibration: %w", err)
+	}
+
+	m.logCalibration("calibrate_rco", status)
+
+	if !status.TRCODone || status.TRCONok || !status.SRCODone || status.SRCONok {
+		return fmt.Errorf("as3935: the calibration of the oscillators was not successful: %w", ErrCalibrationFailed)
+	}
+
+	m.calibratedAt = m.options.clock.Now()
+	return nil
+}
+
+func (m *module) GetCalibrationStatus() (CalibrationStatus, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.getCalibrationStatus()
+}
+
+func (m *module) getCalibrationStatus() (CalibrationStatus, error) {
+	registerTRCO, err := m.i2c.RegRead(RegisterTRCOCalibration)
+	if err != nil {
+		return CalibrationStatus{}, fmt.Errorf("as3935: failed to access the trco calibration register: %w", err)
+	}
+
+	registerSRCO, err := m.i2c.RegRead(RegisterSRCOCalibration)
+	if err != nil {
+		return CalibrationStatus{}, fmt.Errorf("as3935: failed to access the srco calibration register: %w", err)
+	}
+
+	return CalibrationStatus{
+		TRCODone: registerTRCO&0x80 != 0,
+		TRCONok:  registerTRCO&0x40 != 0,
+		SRCODone: registerSRCO&0x80 != 0,
+		SRCONok:  registerSRCO&0x40 != 0,
 	}, nil
 }
 
-type module struct {
-	i2c internal.I2c
-	mu  sync.Mutex
+func (m *module) GetFrequencyDivision() (FrequencyDivision, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	register, err := m.i2c.RegRead(RegisterInterrupt)
+	if err != nil {
+		return FrequencyDiv16, fmt.Errorf("as3935: failed to get the frequency division register: %w", err)
+	}
+
+	return FrequencyDivision(register & 0xC0), nil
 }
 
-func (m *module) GetSpikeRejection() (uint8, error) {
+func (m *module) SetFrequencyDivision(division FrequencyDivision) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.setFrequencyDivision(division)
+}
+
+func (m *module) setFrequencyDivision(division FrequencyDivision) error {
+	switch division {
+	case FrequencyDiv16, FrequencyDiv32, FrequencyDiv64, FrequencyDiv128:
+	default:
+		return fmt.Errorf("as3935: the specified frequency division is out of range: %w", ErrValueOutOfRange)
+	}
+
+	if err := m.i2c.RegWriteMasked(RegisterInterrupt, uint8(division), 0xC0); err != nil {
+		return fmt.Errorf("as3935: failed to set the frequency division register: %w", err)
+	}
+
+	return nil
+}
+
+func (m *module) ClearStatistics() error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	register, err := m.i2c.RegRead(0x02)
+	if err := m.i2c.RegWriteMasked(RegisterStatistics, 0x40, 0x40); err != nil {
+		return fmt.Errorf("as3935: failed to set the clear statistics register high: %w", err)
+	}
+
+	m.options.clock.Sleep(m.options.settleDelay)
+
+	if err := m.i2c.RegWriteMasked(RegisterStatistics, 0x00, 0x40); err != nil {
+		return fmt.Errorf("as3935: failed to set the clear statistics register low: %w", err)
+	}
+
+	m.options.clock.Sleep(m.options.settleDelay)
+
+	if err := m.i2c.RegWriteMasked(RegisterStatistics, 0x40, 0x40); err != nil {
+		return fmt.Errorf("as3935: failed to set the clear statistics register high: %w", err)
+	}
+
+	return nil
+}
+
+func (m *module) GetMinNumberOfLightning() (uint8, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	register, err := m.i2c.RegRead(RegisterStatistics)
+	if err != nil {
+		return 0x00, fmt.Errorf("as3935: failed to get the minimum number of lightning register: %w", err)
+	}
+
+	return MinNumberOfLightning(register & 0x30).Count(), nil
+}
+
+func (m *module) GetMinNumberOfLightningRaw() (uint8, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	register, err := m.i2c.RegRead(RegisterStatistics)
+	if err != nil {
+		return 0x00, fmt.Errorf("as3935: failed to get the minimum number of lightning register: %w", err)
+	}
+
+	register = (register & 0x30) >> 4
+	return register, nil
+}
+
+func (m *module) SetMinNumberOfLightning(minimum MinNumberOfLightning) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.setMinNumberOfLightning(minimum)
+}
+
+func (m *module) setMinNumberOfLightning(minimum MinNumberOfLightning) error {
+	switch minimum {
+	case MinLightning1, MinLightning5, MinLightning9, MinLightning16:
+	default:
+		return fmt.Errorf("as3935: the specified minimum number of lightning is out of range: %w", ErrValueOutOfRange)
+	}
+
+	if err := m.i2c.RegWriteMasked(RegisterStatistics, uint8(minimum), 0x30); err != nil {
+		return fmt.Errorf("as3935: failed to set the minimum number of lightning register: %w", err)
+	}
+
+	return nil
+}
+
+func (m *module) GetSpikeRejection() (uint8, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	register, err := m.i2c.RegRead(RegisterStatistics)
 	if err != nil {
 		return 0x00, fmt.Errorf("as3935: failed to get the spike rejection register: %w", err)
 	}
 
 	register = register & 0x0F
 	if register < 0x00 || register > 0x0B {
-		return 0x00, fmt.Errorf("as3935: the spike rejection had a corrupted value")
+		return 0x00, fmt.Errorf("as3935: the spike rejection had a corrupted value: %w", ErrCorruptedRegister)
 	}
 
 	return register, nil
@@ -220,12 +1083,16 @@ func (m *module) SetSpikeRejection(rejection SpikeRejection) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	return m.setSpikeRejection(rejection)
+}
+
+func (m *module) setSpikeRejection(rejection SpikeRejection) error {
 	rejectionValue := uint8(rejection)
 	if rejectionValue < 0x00 || rejectionValue > 0x0B {
-		return fmt.Errorf("as3935: the specified spike rejection is out of range")
+		return fmt.Errorf("as3935: the specified spike rejection is out of range: %w", ErrValueOutOfRange)
 	}
 
-	if err := m.i2c.RegWriteMasked(0x02, rejectionValue, 0x0F); err != nil {
+	if err := m.i2c.RegWriteMasked(RegisterStatistics, rejectionValue, 0x0F); err != nil {
 		return fmt.Errorf("as3935: failed to set the spike rejection register: %w", err)
 	}
 
@@ -236,12 +1103,16 @@ func (m *module) SetWatchdogThreshold(threshold WatchdogThreshold) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	return m.setWatchdogThreshold(threshold)
+}
+
+func (m *module) setWatchdogThreshold(threshold WatchdogThreshold) error {
 	thresholdValue := uint8(threshold)
 	if thresholdValue < 0x00 || thresholdValue > 0x0A {
-		return fmt.Errorf("as3935: the provided watchdog threshold value is out of range")
+		return fmt.Errorf("as3935: the provided watchdog threshold value is out of range: %w", ErrValueOutOfRange)
 	}
 
-	if err := m.i2c.RegWriteMasked(0x01, thresholdValue, 0x0F); err != nil {
+	if err := m.i2c.RegWriteMasked(RegisterNoiseFloor, thresholdValue, 0x0F); err != nil {
 		return fmt.Errorf("as3935: faield to set the watchdog threshold register: %w", err)
 	}
 
@@ -249,96 +1120,151 @@ func (m *module) SetWatchdogThreshold(threshold WatchdogThreshold) error {
 }
 
 func (m *module) GetWatchdogThreshold() (uint8, error) {
-	m.mu.Lock()
-	defer m.mu.Unlock()
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 
-	register, err := m.i2c.RegRead(0x01)
+	_, watchdog, err := m.getRegister01()
 	if err != nil {
-		return 0x00, fmt.Errorf("as3935: failed to read the watchdog threshold register: %w", err)
+		return 0x00, err
 	}
 
-	register = register & 0x0F
-	if register < 0x00 || register > 0x0A {
-		return 0x0, fmt.Errorf("as3935: the watchdog threshold value had a corrupted value")
+	if watchdog > 0x0A {
+		return 0x00, fmt.Errorf("as3935: the watchdog threshold value had a corrupted value: %w", ErrCorruptedRegister)
 	}
 
-	return register, nil
+	return watchdog, nil
 }
 
 func (m *module) GetNoiseFloorLevel() (uint8, error) {
-	m.mu.Lock()
-	defer m.mu.Unlock()
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	noiseFloor, _, err := m.getRegister01()
+	return noiseFloor, err
+}
+
+func (m *module) GetRegister01() (noiseFloor uint8, watchdog uint8, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.getRegister01()
+}
 
-	register, err := m.i2c.RegRead(0x01)
+// Read the 0x01 register once and decode both the noise floor level and the watchdog threshold. The fields
+// are returned raw, the getters of the individual fields are validating them.
+func (m *module) getRegister01() (noiseFloor uint8, watchdog uint8, err error) {
+	register, err := m.i2c.RegRead(RegisterNoiseFloor)
 	if err != nil {
-		return 0x00, fmt.Errorf("as3935: failed to read the noise floor level reigster: %w", err)
+		return 0x00, 0x00, fmt.Errorf("as3935: failed to read the noise floor level and watchdog threshold register: %w", err)
 	}
 
-	register = (register & 0x70) >> 4
-
-	switch NoiseFloorLevel(register) {
-	case 0x00, 0x10, 0x20, 0x30, 0x40, 0x50, 0x60, 0x70:
-	default:
-		return 0x00, fmt.Errorf("as3935: the provided noise floor level had a corrupted value")
+	noiseFloor = (register & 0x70) >> 4
+	if m.options.observer != nil {
+		m.options.observer.ObserveNoiseFloorLevel(NoiseFloorLevel(register & 0x70))
 	}
 
-	return register, nil
+	watchdog = register & 0x0F
+	return noiseFloor, watchdog, nil
 }
 
 func (m *module) SetNoiseFloorLevel(level NoiseFloorLevel) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	return m.setNoiseFloorLevel(level)
+}
+
+func (m *module) setNoiseFloorLevel(level NoiseFloorLevel) error {
 	switch level {
 	case 0x00, 0x10, 0x20, 0x30, 0x40, 0x50, 0x60, 0x70:
 	default:
-		return fmt.Errorf("as3935: the provided noise floor level value is out of range")
+		return fmt.Errorf("as3935: the provided noise floor level value is out of range: %w", ErrValueOutOfRange)
 	}
 
-	if err := m.i2c.RegWriteMasked(0x01, uint8(level), 0x70); err != nil {
+	if err := m.i2c.RegWriteMasked(RegisterNoiseFloor, uint8(level), 0x70); err != nil {
 		return fmt.Errorf("as3935: failed to set the noise floor level to the register: %w", err)
 	}
 
+	if m.options.observer != nil {
+		m.options.observer.ObserveNoiseFloorLevel(level)
+	}
+
 	return nil
 }
 
 func (m *module) PowerSwitch(power bool) error {
+	return m.PowerSwitchContext(context.Background(), power)
+}
+
+func (m *module) PowerSwitchContext(ctx context.Context, power bool) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	if !power {
-		if err := m.i2c.RegWriteMasked(0x00, 0x01, 0x01); err != nil {
-			return fmt.Errorf("as3935: failed to set the power down value to the register: %w", err)
-		}
+		return m.powerDown()
+	}
 
-		return nil
+	return m.powerUp(ctx)
+}
+
+func (m *module) powerDown() error {
+	if err := m.i2c.RegWriteMasked(RegisterPower, 0x01, 0x01); err != nil {
+		return fmt.Errorf("as3935: failed to set the power down value to the register: %w", err)
 	}
 
-	if err := m.i2c.RegWriteMasked(0x00, 0x00, 0x01); err != nil {
+	return nil
+}
+
+// Power up the module and perform the calibration sequence, which resets the registers to the defaults. The
+// calibration is verified the same way as by CalibrateRCO.
+func (m *module) powerUp(ctx context.Context) error {
+	if err := m.i2c.RegWriteMasked(RegisterPower, 0x00, 0x01); err != nil {
 		return fmt.Errorf("as3935: failed to set the power up value to the register: %w", err)
 	}
 
-	if err := m.i2c.RegWrite(0x3C, 0x96); err != nil {
+	if err := m.i2c.RegWrite(RegisterPresetDefault, m.options.commandKey); err != nil {
 		return fmt.Errorf("as3935: failed to set value to the calibration direct command register: %w", err)
 	}
 
-	if err := m.i2c.RegWriteMasked(0x08, uint8(SRCO), uint8(SRCO)); err != nil {
+	if err := m.i2c.RegWrite(RegisterCalibrateRCO, m.options.commandKey); err != nil {
+		return fmt.Errorf("as3935: failed to set value to the calibrate rco direct command register: %w", err)
+	}
+
+	if err := m.i2c.RegWriteMasked(RegisterTuning, uint8(SRCO), uint8(SRCO)); err != nil {
 		return fmt.Errorf("as3935: failed to set the irq source up as powerup sequence to the register: %w", err)
 	}
 
-	time.Sleep(delayDuration)
+	if err := m.sleepContext(ctx, m.options.settleDelay); err != nil {
+		return err
+	}
 
-	if err := m.i2c.RegWriteMasked(0x08, 0x00, uint8(SRCO)); err != nil {
+	if err := m.i2c.RegWriteMasked(RegisterTuning, 0x00, uint8(SRCO)); err != nil {
 		return fmt.Errorf("as3935: failed to set the irq source down as powerup sequence to the register: %w", err)
 	}
 
+	status, err := m.getCalibrationStatus()
+	if err != nil {
+		return fmt.Errorf("as3935: failed to verify the powerup calibration: %w", err)
+	}
+
+	m.logCalibration("power_up", status)
+
+	if !status.TRCODone || status.TRCONok || !status.SRCODone || status.SRCONok {
+		return fmt.Errorf("as3935: the calibration of the oscillators after powerup was not successful: %w", ErrCalibrationFailed)
+	}
+
+	m.calibratedAt = m.options.clock.Now()
 	return nil
 }
 
 func (m *module) DumpRegisters() ([9]uint8, error) {
-	m.mu.Lock()
-	defer m.mu.Unlock()
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.dumpRegisters()
+}
 
+func (m *module) dumpRegisters() ([9]uint8, error) {
 	var (
 		offset    uint8    = 0
 		registers [9]uint8 = [9]uint8{}
@@ -361,7 +1287,7 @@ func (m *module) DisableDisturber() error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if err := m.i2c.RegWriteMasked(0x03, 0x00, 0x20); err != nil {
+	if err := m.i2c.RegWriteMasked(RegisterInterrupt, 0x00, 0x20); err != nil {
 		return fmt.Errorf("as3935: failed to apply disable of disturber to register: %w", err)
 	}
 
@@ -372,7 +1298,7 @@ func (m *module) EnableDisturber() error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if err := m.i2c.RegWriteMasked(0x03, 0x20, 0x20); err != nil {
+	if err := m.i2c.RegWriteMasked(RegisterInterrupt, 0x20, 0x20); err != nil {
 		return fmt.Errorf("as3935: failed to apply disable of disturber to register: %w", err)
 	}
 
@@ -380,82 +1306,277 @@ func (m *module) EnableDisturber() error {
 }
 
 func (m *module) GetInterruptSource() (InterruptType, error) {
-	m.mu.Lock()
-	defer m.mu.Unlock()
+	return m.GetInterruptSourceContext(context.Background())
+}
+
+// The interrupt register delay is awaited before the lock is acquired, so concurrent calls are not
+// serialized behind the sleep.
+func (m *module) GetInterruptSourceContext(ctx context.Context) (InterruptType, error) {
+	if err := m.sleepContext(ctx, m.options.settleDelay); err != nil {
+		return NoResults, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.getInterruptSource()
+}
 
-	time.Sleep(delayDuration)
+// The caller is responsible for the delay required after the IRQ pin goes high, before the read.
+func (m *module) GetInterruptSourceNow() (InterruptType, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 
-	register, err := m.i2c.RegRead(0x03)
+	return m.getInterruptSource()
+}
+
+// The interrupt register delay is awaited before the lock is acquired, same as for GetInterruptSource.
+func (m *module) GetInterruptRaw() (uint8, error) {
+	if err := m.sleepContext(context.Background(), m.options.settleDelay); err != nil {
+		return 0x00, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	register, err := m.i2c.RegRead(RegisterInterrupt)
 	if err != nil {
-		return NoResults, fmt.Errorf("as3935: failed to access the interrupt register: %w", err)
-	}
-
-	switch register & 0x0F {
-	case uint8(NoResults):
-		return NoResults, nil
-	case uint8(NoiseLevelTooHigh):
-		return NoiseLevelTooHigh, nil
-	case uint8(DisturberDetected):
-		return DisturberDetected, nil
-	case uint8(LightningInterrupt):
-		return LightningInterrupt, nil
+		return 0x00, fmt.Errorf("as3935: failed to access the interrupt register: %w", err)
+	}
+
+	return register, nil
+}
+
+// The interrupt register delay is awaited before the lock is acquired, same as for GetInterruptSource.
+func (m *module) GetInterruptSourceRaw() (InterruptType, uint8, error) {
+	if err := m.sleepContext(context.Background(), m.options.settleDelay); err != nil {
+		return NoResults, 0x00, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.getInterruptSourceRaw()
+}
+
+// Read the interrupt register. The caller is responsible for the delay required before the read.
+func (m *module) getInterruptSource() (InterruptType, error) {
+	interrupt, _, err := m.getInterruptSourceRaw()
+	return interrupt, err
+}
+
+// Read the interrupt register and decode the interrupt type. The values not defined by the datasheet are
+// reported as the InterruptUnknown, or with the ErrCorruptedRegister error if WithStrictInterrupt is set.
+func (m *module) getInterruptSourceRaw() (InterruptType, uint8, error) {
+	register, err := m.i2c.RegRead(RegisterInterrupt)
+	if err != nil {
+		return NoResults, 0x00, fmt.Errorf("as3935: failed to access the interrupt register: %w", err)
+	}
+
+	interrupt := InterruptType(register & 0x0F)
+
+	switch interrupt {
+	case NoResults, NoiseLevelTooHigh, DisturberDetected, LightningInterrupt:
 	default:
-		return NoResults, fmt.Errorf("as3935: invalid or corrupted interrupt data retrievef from register")
+		if m.options.strictInterrupt {
+			return NoResults, register, fmt.Errorf("as3935: invalid or corrupted interrupt data retrievef from register: %w", ErrCorruptedRegister)
+		}
+
+		interrupt = InterruptUnknown
 	}
+
+	m.lastInterrupt.Store(uint32(interrupt))
+
+	if m.options.observer != nil {
+		m.options.observer.ObserveInterrupt(interrupt)
+	}
+
+	m.logInterrupt(interrupt)
+
+	return interrupt, register, nil
 }
 
 func (m *module) GetLightningDistanceKm() (int, error) {
-	m.mu.Lock()
-	defer m.mu.Unlock()
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if err := m.requireLightning(); err != nil {
+		return 0, err
+	}
+
+	return m.getLightningDistanceKm()
+}
+
+// The value is not validated, so the codes not defined by the datasheet are returned as they are.
+func (m *module) GetLightningDistanceRaw() (uint8, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 
-	register, err := m.i2c.RegRead(0x07)
+	if err := m.requireLightning(); err != nil {
+		return 0x00, err
+	}
+
+	register, err := m.i2c.RegRead(RegisterDistance)
+	if err != nil {
+		return 0x00, fmt.Errorf("as3935: failed to access the distance register: %w", err)
+	}
+
+	return register & 0x3F, nil
+}
+
+func (m *module) GetLightningDistanceMiles() (float64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if err := m.requireLightning(); err != nil {
+		return 0, err
+	}
+
+	return m.getLightningDistanceConverted(kilometersToMiles)
+}
+
+func (m *module) GetLightningDistanceNauticalMiles() (float64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if err := m.requireLightning(); err != nil {
+		return 0, err
+	}
+
+	return m.getLightningDistanceConverted(kilometersToNauticalMiles)
+}
+
+// Get the distance converted from kilometers using the provided factor. The "Out of range" distance is
+// represented as positive infinity instead of the math.MaxInt value.
+func (m *module) getLightningDistanceConverted(factor float64) (float64, error) {
+	distance, err := m.getLightningDistanceKm()
+	if err != nil {
+		return 0, err
+	}
+
+	if distance == math.MaxInt {
+		return math.Inf(1), nil
+	}
+
+	return float64(distance) * factor, nil
+}
+
+func (m *module) getLightningDistanceKm() (int, error) {
+	register, err := m.i2c.RegRead(RegisterDistance)
 	if err != nil {
 		return 0, fmt.Errorf("as3935: failed to access the distance register: %w", err)
 	}
 
-	switch register & 0x3F {
-	case 0x01:
-		return 0, nil
-	case 0x3F:
-		return math.MaxInt, nil
+	if !isDistanceDefined(register) {
+		return 0, fmt.Errorf("as3935: the distance had a corrupted value 0x%02x: %w", register&0x3F, ErrCorruptedRegister)
+	}
+
+	return decodeDistanceKm(register), nil
+}
+
+// Decode the distance in KM from the DISTANCE register value. The value "0" corresponds to "Storm ahead"
+// and the "math.MaxInt" correspondes to "Out of range".
+func decodeDistanceKm(register uint8) int {
+	estimation := decodeDistanceEstimation(register)
+
+	switch estimation.Kind {
+	case StormOverhead:
+		return 0
+	case OutOfRange:
+		return math.MaxInt
 	default:
-		return int(register & 0x3F), nil
+		return estimation.Km
 	}
 }
 
 func (m *module) GetStrikeEnergy() (float64, error) {
-	m.mu.Lock()
-	defer m.mu.Unlock()
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 
-	registerL, err := m.i2c.RegRead(0x04)
-	if err != nil {
-		return 0, fmt.Errorf("as3935: failed to access l strike energy register: %w", err)
+	if err := m.requireLightning(); err != nil {
+		return 0, err
 	}
 
-	registerM, err := m.i2c.RegRead(0x05)
+	return m.getStrikeEnergy()
+}
+
+func (m *module) GetStrikeEnergyRaw() (uint32, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if err := m.requireLightning(); err != nil {
+		return 0, err
+	}
+
+	return m.getStrikeEnergyRaw()
+}
+
+func (m *module) getStrikeEnergy() (float64, error) {
+	value, err := m.getStrikeEnergyRaw()
 	if err != nil {
-		return 0, fmt.Errorf("as3935: failed to access m strike energy register: %w", err)
+		return 0, err
 	}
 
-	registerMM, err := m.i2c.RegRead(0x06)
+	return scaleStrikeEnergy(value), nil
+}
+
+// The divisor of the raw strike energy. The datasheet defines the energy as a pure number without a physical
+// unit, so the value is only normalized to the 2^24 range, which is the 16777 * 1000 divisor previously
+// applied in two integer steps, without truncating the values below 16777 to zero.
+const strikeEnergyDivisor float64 = 1 << 24
+
+// Scale the raw 21-bit strike energy value into the dimensionless range from 0.0 to 0.125.
+func scaleStrikeEnergy(value uint32) float64 {
+	return float64(value) / strikeEnergyDivisor
+}
+
+func (m *module) getStrikeEnergyRaw() (uint32, error) {
+	registerL, registerM, registerMM, err := m.getStrikeEnergyBytes()
 	if err != nil {
-		return 0, fmt.Errorf("as3935: failed to access mm strike enregy register: %w", err)
+		return 0, err
+	}
+
+	return decodeStrikeEnergy(registerL, registerM, registerMM), nil
+}
+
+func (m *module) GetStrikeEnergyBytes() (l, mid, mm uint8, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.getStrikeEnergyBytes()
+}
+
+func (m *module) getStrikeEnergyBytes() (l, mid, mm uint8, err error) {
+	if l, err = m.i2c.RegRead(RegisterEnergyL); err != nil {
+		return 0, 0, 0, fmt.Errorf("as3935: failed to access l strike energy register: %w", err)
 	}
 
-	// TODO: Verify if the formula is correct and is host endian agnostic
+	if mid, err = m.i2c.RegRead(RegisterEnergyM); err != nil {
+		return 0, 0, 0, fmt.Errorf("as3935: failed to access m strike energy register: %w", err)
+	}
+
+	if mm, err = m.i2c.RegRead(RegisterEnergyMM); err != nil {
+		return 0, 0, 0, fmt.Errorf("as3935: failed to access mm strike enregy register: %w", err)
+	}
+
+	return l, mid, mm, nil
+}
+
+// Assemble the raw 21-bit strike energy value from the S_LIG_L, S_LIG_M and S_LIG_MM register values. The
+// value is assembled with shifts of the individual register values, so it does not depend on the host endianness.
+func decodeStrikeEnergy(registerL, registerM, registerMM uint8) uint32 {
 	var value uint32 = uint32(registerMM&0x1F) << 16
 	value |= uint32(registerM) << 8
 	value |= uint32(registerL)
-	value /= 16777
 
-	return float64(value) / 1000.0, nil
+	return value
 }
 
 func (m *module) InitializeDefaults() error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if err := m.i2c.RegWrite(0x3C, 0x96); err != nil {
+	if err := m.i2c.RegWrite(RegisterPresetDefault, m.options.commandKey); err != nil {
 		return fmt.Errorf("as3935: failed to apply initialize module defaults to reigster: %w", err)
 	}
 
@@ -466,13 +1587,17 @@ func (m *module) SetAnalogFrontEnd(model AnalogFrontEnd) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	return m.setAnalogFrontEnd(model)
+}
+
+func (m *module) setAnalogFrontEnd(model AnalogFrontEnd) error {
 	switch model {
 	case Indoor, Outdoor:
 	default:
-		return fmt.Errorf("as3935: invalid analog frontend model specified")
+		return fmt.Errorf("as3935: invalid analog frontend model specified: %w", ErrValueOutOfRange)
 	}
 
-	if err := m.i2c.RegWriteMasked(0x00, uint8(model), 0x3E); err != nil {
+	if err := m.i2c.RegWriteMasked(RegisterPower, uint8(model), 0x3E); err != nil {
 		return fmt.Errorf("as3935: failed to apply the analog frontend to the register: %w", err)
 	}
 
@@ -483,13 +1608,17 @@ func (m *module) SetIRQOutputSource(source IRQOutputSource) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	return m.setIRQOutputSource(source)
+}
+
+func (m *module) setIRQOutputSource(source IRQOutputSource) error {
 	switch source {
 	case None, TRCO, SRCO, LCO:
 	default:
-		return fmt.Errorf("as3935: invalid IRQ output source specified")
+		return fmt.Errorf("as3935: invalid IRQ output source specified: %w", ErrValueOutOfRange)
 	}
 
-	if err := m.i2c.RegWriteMasked(0x08, uint8(source), 0xE0); err != nil {
+	if err := m.i2c.RegWriteMasked(RegisterTuning, uint8(source), 0xE0); err != nil {
 		return fmt.Errorf("as3935: failed to apply irq output source to register: %w", err)
 	}
 
@@ -500,23 +1629,34 @@ func (m *module) SetTuningCapacitance(capacitance TuningCapacitance) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	switch capacitance {
-	case TuningDiv16, TuningDiv32, TuningDiv64, TuningDiv128:
-	default:
-		return fmt.Errorf("as3935: invalid tuning capacitance value specified")
+	return m.setTuningCapacitance(capacitance)
+}
+
+func (m *module) setTuningCapacitance(capacitance TuningCapacitance) error {
+	if capacitance > Tuning120pF {
+		return fmt.Errorf("as3935: invalid tuning capacitance value specified: %w", ErrValueOutOfRange)
 	}
 
-	if err := m.i2c.RegWriteMasked(0x08, uint8(capacitance), 0x0F); err != nil {
+	if err := m.i2c.RegWriteMasked(RegisterTuning, uint8(capacitance), 0x0F); err != nil {
 		return fmt.Errorf("as3935: failed to apply the tuning capacitance to register: %w", err)
 	}
 
 	return nil
 }
 
+func (m *module) DevicePath() string {
+	return m.device
+}
+
+func (m *module) Address() int {
+	return m.address
+}
+
 func (m *module) Close() error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	m.generation += 1
 	if err := m.i2c.Close(); err != nil {
 		return fmt.Errorf("as3935: failure during the i2c connection closing: %w", err)
 	}
@@ -525,12 +1665,92 @@ func (m *module) Close() error {
 }
 
 func (m *module) Open() error {
+	return m.OpenContext(context.Background())
+}
+
+// The connection opening is performed in a goroutine if the context can be done. When the context is done
+// first, the opening is abandoned and the connection is closed in the background if it succeeds later,
+// unless the module was opened, reconnected or closed again in the meantime.
+func (m *module) OpenContext(ctx context.Context) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if err := m.i2c.Open(); err != nil {
+	m.generation += 1
+
+	if err := m.openContext(ctx); err != nil {
 		return fmt.Errorf("as3935: failure during the i2c connection opening: %w", err)
 	}
 
+	if err := m.applyOptions(); err != nil {
+		_ = m.i2c.Close()
+		return fmt.Errorf("as3935: failed to apply the module options: %w", err)
+	}
+
+	return nil
+}
+
+// Open the connection within the context. The module lock must be held by the caller. The transport is
+// captured while the lock is held, because the abandoned opening keeps running without the lock while
+// the transport of the module can be replaced, for example by SetDebugOutput.
+func (m *module) openContext(ctx context.Context) error {
+	var (
+		i2c        = m.i2c
+		generation = m.generation
+	)
+
+	if ctx.Done() == nil {
+		return i2c.Open()
+	}
+
+	result := make(chan error, 1)
+	go func() {
+		result <- i2c.Open()
+	}()
+
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		go func() {
+			if err := <-result; err == nil {
+				m.mu.Lock()
+				defer m.mu.Unlock()
+
+				if m.generation == generation {
+					_ = i2c.Close()
+				}
+			}
+		}()
+
+		return ctx.Err()
+	}
+}
+
+// The close errors are ignored, because the connection is expected to be broken. The connection is closed
+// again if the initialization after the opening fails, same as for Open. The register options are
+// applied again and the last state snapshot taken with SnapshotState or restored with RestoreState is restored.
+func (m *module) Reconnect() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.generation += 1
+	_ = m.i2c.Close()
+
+	if err := m.i2c.Open(); err != nil {
+		return fmt.Errorf("as3935: failure during the i2c connection reopening: %w", err)
+	}
+
+	if err := m.applyOptions(); err != nil {
+		_ = m.i2c.Close()
+		return fmt.Errorf("as3935: failed to apply the module options: %w", err)
+	}
+
+	if m.snapshot != nil {
+		if err := m.restoreState(m.snapshot); err != nil {
+			_ = m.i2c.Close()
+			return fmt.Errorf("as3935: failed to restore the module state snapshot: %w", err)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,418 @@
+package as3935go
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Krzysztofz01/as3935-go/internal"
+)
+
+// The in-memory transport blocking the Open until the release channel is closed and reporting the Close.
+type blockingOpenTransport struct {
+	*internal.MemoryDevice
+	release chan struct{}
+	closed  chan struct{}
+}
+
+func (t *blockingOpenTransport) Open() error {
+	<-t.release
+	return t.MemoryDevice.Open()
+}
+
+func (t *blockingOpenTransport) Close() error {
+	defer close(t.closed)
+	return t.MemoryDevice.Close()
+}
+
+// The in-memory transport failing the first write after the Open and recording the Close.
+type failingWriteTransport struct {
+	*internal.MemoryDevice
+	failed bool
+	closed bool
+}
+
+var errWriteFailed = errors.New("write failed")
+
+func (t *failingWriteTransport) RegWrite(offset, value uint8) error {
+	if !t.failed {
+		t.failed = true
+		return errWriteFailed
+	}
+
+	return t.MemoryDevice.RegWrite(offset, value)
+}
+
+func (t *failingWriteTransport) RegWriteMasked(offset, value, mask uint8) error {
+	if !t.failed {
+		t.failed = true
+		return errWriteFailed
+	}
+
+	return t.MemoryDevice.RegWriteMasked(offset, value, mask)
+}
+
+func (t *failingWriteTransport) Close() error {
+	t.closed = true
+	return t.MemoryDevice.Close()
+}
+
+func TestOpenClosesTheTransportWhenTheOptionsFail(t *testing.T) {
+	transport := &failingWriteTransport{MemoryDevice: internal.NewMemoryDevice()}
+
+	m, err := NewModuleWithTransport(transport, 0x03, WithAnalogFrontEnd(Outdoor))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.Open(); !errors.Is(err, errWriteFailed) {
+		t.Fatalf("expected the write failure, got %v", err)
+	}
+
+	if !transport.closed {
+		t.Fatal("expected the transport to be closed after the failed open")
+	}
+}
+
+func TestOpenContextAbandonedOpenDoesNotRaceWithSetDebugOutput(t *testing.T) {
+	transport := &blockingOpenTransport{
+		MemoryDevice: internal.NewMemoryDevice(),
+		release:      make(chan struct{}),
+		closed:       make(chan struct{}),
+	}
+
+	m, err := NewModuleWithTransport(transport, 0x03)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := m.OpenContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the deadline exceeded error, got %v", err)
+	}
+
+	m.SetDebugOutput(io.Discard)
+	close(transport.release)
+
+	// NOTE: The abandoned opening closes the connection in the background after it succeeds
+	select {
+	case <-transport.closed:
+	case <-time.After(time.Second):
+		t.Fatal("the abandoned connection has not been closed")
+	}
+}
+
+// The in-memory transport blocking only the first Open until the release channel is closed and counting the
+// closed connections. The late opening succeeds without checking the connection state.
+type lateOpenTransport struct {
+	*internal.MemoryDevice
+	release chan struct{}
+	opened  chan struct{}
+	opens   atomic.Int32
+	closes  atomic.Int32
+}
+
+func (t *lateOpenTransport) Open() error {
+	if t.opens.Add(1) == 1 {
+		<-t.release
+		close(t.opened)
+		return nil
+	}
+
+	return t.MemoryDevice.Open()
+}
+
+func (t *lateOpenTransport) Close() error {
+	t.closes.Add(1)
+	return nil
+}
+
+func TestOpenContextAbandonedOpenDoesNotCloseLaterConnection(t *testing.T) {
+	transport := &lateOpenTransport{
+		MemoryDevice: internal.NewMemoryDevice(),
+		release:      make(chan struct{}),
+		opened:       make(chan struct{}),
+	}
+
+	m, err := NewModuleWithTransport(transport, 0x03)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := m.OpenContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the deadline exceeded error, got %v", err)
+	}
+
+	if err := m.Open(); err != nil {
+		t.Fatalf("failed to open the module again: %s", err)
+	}
+
+	close(transport.release)
+	<-transport.opened
+
+	// NOTE: The abandoned opening takes the module lock before the closing, so the lock is waited for
+	if _, err := m.ReadRegister(RegisterPower); err != nil {
+		t.Fatalf("failed to read the register: %s", err)
+	}
+
+	time.Sleep(10 * time.Millisecond)
+
+	if closes := transport.closes.Load(); closes != 0 {
+		t.Fatalf("expected the reopened connection to stay open, got %d closes", closes)
+	}
+}
+
+func TestStrictInterruptIsIndependentOfStrictMode(t *testing.T) {
+	clock := NewFakeClock(time.Unix(0, 0))
+
+	m := openMockModule(t, WithClock(clock), WithStrictMode())
+	m.SetRegister(RegisterInterrupt, 0x05)
+	if interrupt, err := m.GetInterruptSource(); err != nil || interrupt != InterruptUnknown {
+		t.Fatalf("expected the unknown interrupt in the strict mode, got %v and %v", interrupt, err)
+	}
+
+	m = openMockModule(t, WithClock(clock), WithStrictInterrupt())
+	m.SetRegister(RegisterInterrupt, 0x05)
+	if _, err := m.GetInterruptSource(); !errors.Is(err, ErrCorruptedRegister) {
+		t.Fatalf("expected the corrupted register error, got %v", err)
+	}
+
+	m.InjectEvent(StrikeEvent{Type: NoiseLevelTooHigh})
+	if _, err := m.GetLightningDistanceKm(); err != nil {
+		t.Fatalf("expected the distance read without the strict mode, got %s", err)
+	}
+}
+
+func TestClearStatisticsTogglesTheBitHighLowHigh(t *testing.T) {
+	transport := NewObserveTransport(internal.NewMemoryDevice())
+
+	m, err := NewModuleWithTransport(transport, 0x03, WithClock(NewFakeClock(time.Unix(0, 0))))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.Open(); err != nil {
+		t.Fatalf("failed to open the module: %s", err)
+	}
+	t.Cleanup(func() { _ = m.Close() })
+
+	transport.Reset()
+	if err := m.ClearStatistics(); err != nil {
+		t.Fatalf("failed to clear the statistics: %s", err)
+	}
+
+	expected := []ObservedWrite{
+		{Offset: RegisterStatistics, Value: 0x40, Mask: 0x40},
+		{Offset: RegisterStatistics, Value: 0x00, Mask: 0x40},
+		{Offset: RegisterStatistics, Value: 0x40, Mask: 0x40},
+	}
+
+	if writes := transport.Writes(); !reflect.DeepEqual(writes, expected) {
+		t.Fatalf("expected the writes %v, got %v", expected, writes)
+	}
+}
+
+func TestGetIRQOutputSource(t *testing.T) {
+	m := openMockModule(t)
+
+	for _, source := range []IRQOutputSource{None, TRCO, SRCO, LCO} {
+		// NOTE: The tuning capacitance bits are set to verify they are masked out
+		m.SetRegister(RegisterTuning, uint8(source)|0x0A)
+
+		actual, err := m.GetIRQOutputSource()
+		if err != nil {
+			t.Fatalf("failed to get the irq output source: %s", err)
+		}
+
+		if actual != source {
+			t.Fatalf("expected the irq output source %s, got %s", source, actual)
+		}
+	}
+
+	for _, register := range []uint8{0x60, 0xA0, 0xC0, 0xE0} {
+		m.SetRegister(RegisterTuning, register)
+
+		if _, err := m.GetIRQOutputSource(); !errors.Is(err, ErrCorruptedRegister) {
+			t.Fatalf("expected the corrupted register error for %#02x, got %v", register, err)
+		}
+	}
+}
+
+func TestGetStrikeEnergyRawAndScaled(t *testing.T) {
+	m := openMockModule(t)
+	m.SetRegister(RegisterEnergyL, 0x56)
+	m.SetRegister(RegisterEnergyM, 0x34)
+	m.SetRegister(RegisterEnergyMM, 0x12)
+
+	raw, err := m.GetStrikeEnergyRaw()
+	if err != nil {
+		t.Fatalf("failed to get the raw strike energy: %s", err)
+	}
+
+	if raw != 0x123456 {
+		t.Fatalf("expected the raw strike energy 0x123456, got %#06x", raw)
+	}
+
+	energy, err := m.GetStrikeEnergy()
+	if err != nil {
+		t.Fatalf("failed to get the strike energy: %s", err)
+	}
+
+	if expected := float64(0x123456) / (1 << 24); energy != expected {
+		t.Fatalf("expected the strike energy %f, got %f", expected, energy)
+	}
+}
+
+func TestDecodeStrikeEnergy(t *testing.T) {
+	cases := []struct {
+		l, m, mm uint8
+		expected uint32
+	}{
+		{0x00, 0x00, 0x00, 0x000000},
+		{0x01, 0x00, 0x00, 0x000001},
+		{0x00, 0x01, 0x00, 0x000100},
+		{0x00, 0x00, 0x01, 0x010000},
+		{0xFF, 0xFF, 0x1F, 0x1FFFFF},
+		// NOTE: The bits above the 21-bit range of the MM register are reserved and discarded
+		{0xFF, 0xFF, 0xFF, 0x1FFFFF},
+		{0x56, 0x34, 0x12, 0x123456},
+		{0x12, 0x34, 0x16, 0x163412},
+	}
+
+	for _, c := range cases {
+		if actual := decodeStrikeEnergy(c.l, c.m, c.mm); actual != c.expected {
+			t.Fatalf("expected %#06x for the %#02x/%#02x/%#02x registers, got %#06x", c.expected, c.l, c.m, c.mm, actual)
+		}
+	}
+}
+
+func TestConcurrentGetInterruptSourceDoesNotStackTheDelay(t *testing.T) {
+	const (
+		delay      = 50 * time.Millisecond
+		goroutines = 8
+	)
+
+	m := openMockModule(t, WithSettleDelay(delay))
+
+	var (
+		wg    sync.WaitGroup
+		start = time.Now()
+	)
+
+	for index := 0; index < goroutines; index += 1 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, err := m.GetInterruptSource(); err != nil {
+				t.Errorf("failed to get the interrupt source: %s", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if elapsed := time.Since(start); elapsed >= goroutines*delay/2 {
+		t.Fatalf("expected the delays to overlap, the calls took %s", elapsed)
+	}
+}
+
+func TestGetMinNumberOfLightning(t *testing.T) {
+	m := openMockModule(t)
+
+	cases := []struct {
+		minimum MinNumberOfLightning
+		count   uint8
+		raw     uint8
+	}{
+		{MinLightning1, 1, 0x00},
+		{MinLightning5, 5, 0x01},
+		{MinLightning9, 9, 0x02},
+		{MinLightning16, 16, 0x03},
+	}
+
+	for _, c := range cases {
+		if err := m.SetMinNumberOfLightning(c.minimum); err != nil {
+			t.Fatalf("failed to set the minimum number of lightning: %s", err)
+		}
+
+		count, err := m.GetMinNumberOfLightning()
+		if err != nil {
+			t.Fatalf("failed to get the minimum number of lightning: %s", err)
+		}
+
+		if count != c.count {
+			t.Fatalf("expected the count %d for %s, got %d", c.count, c.minimum, count)
+		}
+
+		raw, err := m.GetMinNumberOfLightningRaw()
+		if err != nil {
+			t.Fatalf("failed to get the raw minimum number of lightning: %s", err)
+		}
+
+		if raw != c.raw {
+			t.Fatalf("expected the raw field %#02x for %s, got %#02x", c.raw, c.minimum, raw)
+		}
+	}
+}
+
+func TestSetFrequencyDivisionPreservesMaskDist(t *testing.T) {
+	m := openMockModule(t)
+
+	m.SetRegister(RegisterInterrupt, 0x20)
+
+	for _, division := range []FrequencyDivision{FrequencyDiv16, FrequencyDiv32, FrequencyDiv64, FrequencyDiv128} {
+		if err := m.SetFrequencyDivision(division); err != nil {
+			t.Fatalf("failed to set the frequency division: %s", err)
+		}
+
+		actual, err := m.GetFrequencyDivision()
+		if err != nil {
+			t.Fatalf("failed to get the frequency division: %s", err)
+		}
+
+		if actual != division {
+			t.Fatalf("expected the frequency division %s, got %s", division, actual)
+		}
+
+		if masked, err := m.IsDisturberMasked(); err != nil || !masked {
+			t.Fatalf("expected the disturbers to stay masked after setting %s, got %t and %v", division, masked, err)
+		}
+	}
+
+	if err := m.SetFrequencyDivision(FrequencyDivision(0x20)); !errors.Is(err, ErrValueOutOfRange) {
+		t.Fatalf("expected the value out of range error, got %v", err)
+	}
+
+	if register := m.GetRegister(RegisterInterrupt); register != uint8(FrequencyDiv128)|0x20 {
+		t.Fatalf("expected the interrupt register %#02x, got %#02x", uint8(FrequencyDiv128)|0x20, register)
+	}
+}
+
+func TestRegister01FieldsAreValidatedSeparately(t *testing.T) {
+	m := openMockModule(t)
+	m.SetRegister(RegisterNoiseFloor, 0x3F)
+
+	if level, err := m.GetNoiseFloorLevel(); err != nil || level != 0x03 {
+		t.Fatalf("expected the noise floor level 3, got %d and %v", level, err)
+	}
+
+	if _, err := m.GetWatchdogThreshold(); !errors.Is(err, ErrCorruptedRegister) {
+		t.Fatalf("expected the corrupted register error, got %v", err)
+	}
+
+	noiseFloor, watchdog, err := m.GetRegister01()
+	if err != nil || noiseFloor != 0x03 || watchdog != 0x0F {
+		t.Fatalf("expected the raw 3 and 15 values, got %d, %d and %v", noiseFloor, watchdog, err)
+	}
+}
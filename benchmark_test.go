@@ -0,0 +1,65 @@
+package as3935go
+
+import "testing"
+
+func BenchmarkRegRead(b *testing.B) {
+	m := openMockModule(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for index := 0; index < b.N; index += 1 {
+		m.mu.RLock()
+		_, err := m.i2c.RegRead(RegisterNoiseFloor)
+		m.mu.RUnlock()
+
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkRegWriteMasked(b *testing.B) {
+	m := openMockModule(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for index := 0; index < b.N; index += 1 {
+		m.mu.Lock()
+		err := m.i2c.RegWriteMasked(RegisterNoiseFloor, uint8(index), 0x0F)
+		m.mu.Unlock()
+
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// The settle delay is disabled, so only the register reads and the decoding are measured.
+func BenchmarkReadStrikeEvent(b *testing.B) {
+	m := openMockModule(b, WithSettleDelay(0))
+	m.InjectEvent(StrikeEvent{Type: LightningInterrupt, DistanceKm: 10, Energy: 0.01})
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for index := 0; index < b.N; index += 1 {
+		if _, err := m.ReadStrikeEvent(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDumpRegisters(b *testing.B) {
+	m := openMockModule(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for index := 0; index < b.N; index += 1 {
+		if _, err := m.DumpRegisters(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
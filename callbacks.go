@@ -0,0 +1,100 @@
+package as3935go
+
+import (
+	"context"
+	"fmt"
+)
+
+// The interrupt callbacks and the state of the background goroutine started with Start.
+type callbacks struct {
+	onInterrupt func(StrikeEvent)
+	onError     func(error)
+	cancel      context.CancelFunc
+	done        chan struct{}
+}
+
+func (m *module) OnInterrupt(handler func(StrikeEvent)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.callbacks.onInterrupt = handler
+}
+
+func (m *module) OnError(handler func(error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.callbacks.onError = handler
+}
+
+func (m *module) Start(ctx context.Context, irqPin GPIO) error {
+	if irqPin == nil {
+		return fmt.Errorf("as3935: invalid irq pin specified")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.callbacks.cancel != nil {
+		return fmt.Errorf("as3935: the interrupt handling can not be started: %w", ErrAlreadyStarted)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	m.callbacks.cancel = cancel
+	m.callbacks.done = done
+
+	go func() {
+		defer close(done)
+		defer m.clearCallbacks(done)
+
+		m.watchIRQ(ctx, irqPin, func(event StrikeEvent) {
+			m.mu.RLock()
+			handler := m.callbacks.onInterrupt
+			m.mu.RUnlock()
+
+			if handler != nil {
+				handler(event)
+			}
+		}, func(err error) {
+			m.mu.RLock()
+			handler := m.callbacks.onError
+			m.mu.RUnlock()
+
+			if handler != nil {
+				handler(err)
+			}
+		})
+	}()
+
+	return nil
+}
+
+// Clear the state of the goroutine started with Start when the goroutine exits, so the interrupt handling
+// can be started again after the context of the caller is done. The state of a later Start is kept.
+func (m *module) clearCallbacks(done chan struct{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.callbacks.done != done {
+		return
+	}
+
+	m.callbacks.cancel()
+	m.callbacks.cancel, m.callbacks.done = nil, nil
+}
+
+func (m *module) Stop() {
+	m.mu.Lock()
+	cancel, done := m.callbacks.cancel, m.callbacks.done
+	m.callbacks.cancel, m.callbacks.done = nil, nil
+	m.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+
+	cancel()
+	<-done
+}
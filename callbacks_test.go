@@ -0,0 +1,49 @@
+package as3935go
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// The IRQ pin without the edges.
+type idlePin struct{}
+
+func (idlePin) WaitForEdge(timeout time.Duration) bool {
+	time.Sleep(time.Millisecond)
+	return false
+}
+
+func TestStartAfterTheContextIsDone(t *testing.T) {
+	m := openMockModule(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := m.Start(ctx, idlePin{}); err != nil {
+		t.Fatalf("failed to start the interrupt handling: %s", err)
+	}
+
+	if err := m.Start(context.Background(), idlePin{}); !errors.Is(err, ErrAlreadyStarted) {
+		t.Fatalf("expected the already started error, got %v", err)
+	}
+
+	m.mu.RLock()
+	done := m.callbacks.done
+	m.mu.RUnlock()
+
+	cancel()
+	<-done
+
+	if err := m.Start(context.Background(), idlePin{}); err != nil {
+		t.Fatalf("failed to start the interrupt handling again: %s", err)
+	}
+
+	m.Stop()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if m.callbacks.cancel != nil || m.callbacks.done != nil {
+		t.Fatal("expected the interrupt handling state to be cleared after the stop")
+	}
+}
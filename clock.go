@@ -0,0 +1,78 @@
+package as3935go
+
+import (
+	"sync"
+	"time"
+)
+
+// The source of the current time and the delays used by the module, like the settle delays, the polling
+// intervals and the event timestamps. The default clock is using the time package.
+type Clock interface {
+	// Get the current time.
+	Now() time.Time
+
+	// Pause the current goroutine for the given duration.
+	Sleep(d time.Duration)
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
+
+// Create a new fake clock starting at the given time.
+func NewFakeClock(start time.Time) *FakeClock {
+	return &FakeClock{
+		now:    start,
+		sleeps: make([]time.Duration, 0),
+		mu:     sync.Mutex{},
+	}
+}
+
+// The clock for the deterministic timing in tests. The Sleep returns immediately, advances the time by the
+// given duration and records the duration.
+type FakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+	mu     sync.Mutex
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *FakeClock) Sleep(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.sleeps = append(c.sleeps, d)
+	if d > 0 {
+		c.now = c.now.Add(d)
+	}
+}
+
+// Advance the time by the given duration without recording a sleep.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+// Get the copy of the durations requested via Sleep in the order of the calls.
+func (c *FakeClock) Sleeps() []time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sleeps := make([]time.Duration, len(c.sleeps))
+	copy(sleeps, c.sleeps)
+	return sleeps
+}
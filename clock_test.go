@@ -0,0 +1,52 @@
+package as3935go
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFakeClockRecordsSettleDelays(t *testing.T) {
+	clock := NewFakeClock(time.Unix(0, 0))
+	m := openMockModule(t, WithClock(clock), WithSettleDelay(3*time.Millisecond))
+
+	if err := m.PowerSwitch(true); err != nil {
+		t.Fatalf("failed to power up the module: %s", err)
+	}
+
+	if err := m.ClearStatistics(); err != nil {
+		t.Fatalf("failed to clear the statistics: %s", err)
+	}
+
+	expected := []time.Duration{3 * time.Millisecond, 3 * time.Millisecond, 3 * time.Millisecond}
+	if sleeps := clock.Sleeps(); !reflect.DeepEqual(sleeps, expected) {
+		t.Fatalf("expected the sleeps %v, got %v", expected, sleeps)
+	}
+
+	if now := clock.Now(); !now.Equal(time.Unix(0, 0).Add(9 * time.Millisecond)) {
+		t.Fatalf("expected the clock to advance by 9ms, got %s", now.Sub(time.Unix(0, 0)))
+	}
+}
+
+func TestStatisticsUseModuleClock(t *testing.T) {
+	var (
+		clock      = NewFakeClock(time.Unix(0, 0))
+		statistics = NewStatistics(8)
+		m          = openMockModule(t, WithClock(clock), WithStatistics(statistics))
+	)
+
+	m.InjectEvent(StrikeEvent{Type: LightningInterrupt, DistanceKm: 10})
+	if _, err := m.ReadStrikeEvent(); err != nil {
+		t.Fatalf("failed to read the strike event: %s", err)
+	}
+
+	if strikes := statistics.RecentStrikes(time.Minute); len(strikes) != 1 {
+		t.Fatalf("expected 1 recent strike, got %d", len(strikes))
+	}
+
+	clock.Advance(2 * time.Minute)
+
+	if strikes := statistics.RecentStrikes(time.Minute); len(strikes) != 0 {
+		t.Fatalf("expected no recent strikes after the clock advanced, got %d", len(strikes))
+	}
+}
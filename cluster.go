@@ -0,0 +1,129 @@
+package as3935go
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// The strike event tagged with the index of the cluster module which reported it.
+type ClusterEvent struct {
+	Index int
+	Event StrikeEvent
+}
+
+// Create a cluster coordinating the provided modules. The modules are identified by their index.
+func NewCluster(modules ...Module) *Cluster {
+	return &Cluster{
+		modules: modules,
+	}
+}
+
+// The coordinator of multiple modules. The operations are performed on all modules and the errors of
+// individual modules are collected instead of aborting the whole operation.
+type Cluster struct {
+	modules []Module
+}
+
+// Get the modules of the cluster.
+func (c *Cluster) Modules() []Module {
+	return c.modules
+}
+
+// Open all modules of the cluster. The returned error joins the errors of all failed modules.
+func (c *Cluster) Open() error {
+	return c.each(func(module Module) error {
+		return module.Open()
+	})
+}
+
+// Close all modules of the cluster. The returned error joins the errors of all failed modules.
+func (c *Cluster) Close() error {
+	return c.each(func(module Module) error {
+		return module.Close()
+	})
+}
+
+// Read the strike events of all modules concurrently. The events are ordered by the module index, the
+// event of a failed module is left empty and the returned error joins the errors of all failed modules.
+func (c *Cluster) ReadAll() ([]StrikeEvent, error) {
+	var (
+		events = make([]StrikeEvent, len(c.modules))
+		errs   = make([]error, len(c.modules))
+		wg     = sync.WaitGroup{}
+	)
+
+	for index, module := range c.modules {
+		wg.Add(1)
+		go func(index int, module Module) {
+			defer wg.Done()
+
+			event, err := module.ReadStrikeEvent()
+			if err != nil {
+				errs[index] = fmt.Errorf("as3935: the cluster module %d failed: %w", index, err)
+				return
+			}
+
+			events[index] = event
+		}(index, module)
+	}
+
+	wg.Wait()
+	return events, errors.Join(errs...)
+}
+
+// Watch the IRQ pins of all modules and merge the strike events into a single channel. The irq pins are
+// matched with the modules by the index. The channel is closed when the context is done.
+func (c *Cluster) Watch(ctx context.Context, irqPins []GPIO) (<-chan ClusterEvent, error) {
+	if len(irqPins) != len(c.modules) {
+		return nil, fmt.Errorf("as3935: the count of the irq pins does not match the count of the modules: %w", ErrValueOutOfRange)
+	}
+
+	sources := make([]<-chan StrikeEvent, len(c.modules))
+	for index, module := range c.modules {
+		source, err := module.Watch(ctx, irqPins[index])
+		if err != nil {
+			return nil, fmt.Errorf("as3935: failed to watch the cluster module %d: %w", index, err)
+		}
+
+		sources[index] = source
+	}
+
+	var (
+		events = make(chan ClusterEvent)
+		wg     = sync.WaitGroup{}
+	)
+
+	for index, source := range sources {
+		wg.Add(1)
+		go func(index int, source <-chan StrikeEvent) {
+			defer wg.Done()
+
+			for event := range source {
+				select {
+				case events <- ClusterEvent{Index: index, Event: event}:
+				case <-ctx.Done():
+				}
+			}
+		}(index, source)
+	}
+
+	go func() {
+		wg.Wait()
+		close(events)
+	}()
+
+	return events, nil
+}
+
+func (c *Cluster) each(operation func(Module) error) error {
+	errs := make([]error, 0, len(c.modules))
+	for index, module := range c.modules {
+		if err := operation(module); err != nil {
+			errs = append(errs, fmt.Errorf("as3935: the cluster module %d failed: %w", index, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
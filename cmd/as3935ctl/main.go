@@ -0,0 +1,181 @@
+// The command line tool for the bring-up of the AS3935 module, which uses only the public API of the library.
+//
+//	as3935ctl [--device /dev/i2c-1] [--address 0x03] <command> [arguments]
+//
+// The commands:
+//
+//	dump                      print the decoded registers from 0x00 to 0x08
+//	set afe <indoor|outdoor>  set the analog front end
+//	set nf <0-7>              set the noise floor level
+//	set wdth <0-10>           set the watchdog threshold
+//	set srej <0-11>           set the spike rejection
+//	power <on|off>            power up (including the calibration) or power down the module
+//	watch [--poll 100ms]      print the interrupts until interrupted
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+
+	as3935 "github.com/Krzysztofz01/as3935-go"
+)
+
+func main() {
+	var (
+		device  = flag.String("device", "/dev/i2c-1", "the path of the i2c device")
+		address = flag.String("address", "0x03", "the i2c address of the module")
+	)
+
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: as3935ctl [flags] <dump|set|power|watch> [arguments]\n")
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(*device, *address, flag.Arg(0), flag.Args()[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "as3935ctl: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(device, address, command string, args []string) error {
+	parsedAddress, err := strconv.ParseInt(address, 0, 0)
+	if err != nil {
+		return fmt.Errorf("invalid address %q: %w", address, err)
+	}
+
+	module, err := as3935.NewModule(device, int(parsedAddress))
+	if err != nil {
+		return err
+	}
+
+	if err := module.Open(); err != nil {
+		return err
+	}
+
+	defer module.Close()
+
+	switch command {
+	case "dump":
+		return dump(module)
+	case "set":
+		return set(module, args)
+	case "power":
+		return power(module, args)
+	case "watch":
+		return watch(module, args)
+	default:
+		return fmt.Errorf("unknown command %q", command)
+	}
+}
+
+func dump(module as3935.Module) error {
+	dump, err := module.DumpRegistersString()
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(dump)
+	return nil
+}
+
+func set(module as3935.Module, args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("usage: set <afe|nf|wdth|srej> <value>")
+	}
+
+	field, value := args[0], args[1]
+
+	if field == "afe" {
+		var model as3935.AnalogFrontEnd
+		switch strings.ToLower(value) {
+		case "indoor":
+			model = as3935.Indoor
+		case "outdoor":
+			model = as3935.Outdoor
+		default:
+			return fmt.Errorf("invalid analog front end %q, expected indoor or outdoor", value)
+		}
+
+		if err := module.SetAnalogFrontEnd(model); err != nil {
+			return err
+		}
+
+		fmt.Printf("AFE_GB: %s\n", model)
+		return nil
+	}
+
+	// NOTE: The values are checked against the width of the register field before they are shifted
+	// into it, the library validates the ranges defined by the datasheet afterwards
+	limits := map[string]uint64{"nf": 0x07, "wdth": 0x0F, "srej": 0x0F}
+
+	limit, ok := limits[field]
+	if !ok {
+		return fmt.Errorf("unknown field %q, expected afe, nf, wdth or srej", field)
+	}
+
+	number, err := strconv.ParseUint(value, 0, 8)
+	if err != nil || number > limit {
+		return fmt.Errorf("invalid %s value %q, usage: set %s <0-%d>", field, value, field, limit)
+	}
+
+	switch field {
+	case "nf":
+		level := as3935.NoiseFloorLevel(number << 4)
+		if err := module.SetNoiseFloorLevel(level); err != nil {
+			return err
+		}
+
+		fmt.Printf("NF_LEV: %s\n", level)
+	case "wdth":
+		threshold := as3935.WatchdogThreshold(number)
+		if err := module.SetWatchdogThreshold(threshold); err != nil {
+			return err
+		}
+
+		fmt.Printf("WDTH: %s\n", threshold)
+	case "srej":
+		rejection := as3935.SpikeRejection(number)
+		if err := module.SetSpikeRejection(rejection); err != nil {
+			return err
+		}
+
+		fmt.Printf("SREJ: %s\n", rejection)
+	}
+
+	return nil
+}
+
+func power(module as3935.Module, args []string) error {
+	if len(args) != 1 || (args[0] != "on" && args[0] != "off") {
+		return fmt.Errorf("usage: power <on|off>")
+	}
+
+	return module.PowerSwitch(args[0] == "on")
+}
+
+func watch(module as3935.Module, args []string) error {
+	flags := flag.NewFlagSet("watch", flag.ContinueOnError)
+	poll := flags.Duration("poll", 100*time.Millisecond, "the interval of the interrupt register polling")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	return module.LogInterrupts(ctx, os.Stdout, *poll)
+}
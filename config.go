@@ -0,0 +1,165 @@
+package as3935go
+
+import (
+	"fmt"
+)
+
+// The complete configuration of the module registers.
+type Config struct {
+	AnalogFrontEnd       AnalogFrontEnd
+	NoiseFloorLevel      NoiseFloorLevel
+	WatchdogThreshold    WatchdogThreshold
+	SpikeRejection       SpikeRejection
+	MinNumberOfLightning MinNumberOfLightning
+	DisturberMasked      bool
+	TuningCapacitance    TuningCapacitance
+	IRQOutputSource      IRQOutputSource
+}
+
+func (m *module) ApplyConfig(cfg Config) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.applyConfig(cfg)
+}
+
+// All fields are validated before the first write, so an invalid config leaves the registers untouched.
+func (m *module) applyConfig(cfg Config) error {
+	if err := validateConfig(cfg); err != nil {
+		return err
+	}
+
+	if err := m.setAnalogFrontEnd(cfg.AnalogFrontEnd); err != nil {
+		return fmt.Errorf("as3935: failed to apply the config analog frontend: %w", err)
+	}
+
+	if err := m.setNoiseFloorLevel(cfg.NoiseFloorLevel); err != nil {
+		return fmt.Errorf("as3935: failed to apply the config noise floor level: %w", err)
+	}
+
+	if err := m.setWatchdogThreshold(cfg.WatchdogThreshold); err != nil {
+		return fmt.Errorf("as3935: failed to apply the config watchdog threshold: %w", err)
+	}
+
+	if err := m.setSpikeRejection(cfg.SpikeRejection); err != nil {
+		return fmt.Errorf("as3935: failed to apply the config spike rejection: %w", err)
+	}
+
+	if err := m.setMinNumberOfLightning(cfg.MinNumberOfLightning); err != nil {
+		return fmt.Errorf("as3935: failed to apply the config minimum number of lightning: %w", err)
+	}
+
+	var disturberMask uint8 = 0x00
+	if cfg.DisturberMasked {
+		disturberMask = 0x20
+	}
+
+	if err := m.i2c.RegWriteMasked(RegisterInterrupt, disturberMask, 0x20); err != nil {
+		return fmt.Errorf("as3935: failed to apply the config disturber mask: %w", err)
+	}
+
+	if err := m.setTuningCapacitance(cfg.TuningCapacitance); err != nil {
+		return fmt.Errorf("as3935: failed to apply the config tuning capacitance: %w", err)
+	}
+
+	if err := m.setIRQOutputSource(cfg.IRQOutputSource); err != nil {
+		return fmt.Errorf("as3935: failed to apply the config irq output source: %w", err)
+	}
+
+	return nil
+}
+
+// Validate the ranges of all config fields.
+func validateConfig(cfg Config) error {
+	switch cfg.AnalogFrontEnd {
+	case Indoor, Outdoor:
+	default:
+		return fmt.Errorf("as3935: invalid config analog frontend model specified: %w", ErrValueOutOfRange)
+	}
+
+	switch cfg.NoiseFloorLevel {
+	case 0x00, 0x10, 0x20, 0x30, 0x40, 0x50, 0x60, 0x70:
+	default:
+		return fmt.Errorf("as3935: the config noise floor level value is out of range: %w", ErrValueOutOfRange)
+	}
+
+	if cfg.WatchdogThreshold > WDTH10 {
+		return fmt.Errorf("as3935: the config watchdog threshold value is out of range: %w", ErrValueOutOfRange)
+	}
+
+	if cfg.SpikeRejection > SREJ11 {
+		return fmt.Errorf("as3935: the config spike rejection is out of range: %w", ErrValueOutOfRange)
+	}
+
+	switch cfg.MinNumberOfLightning {
+	case MinLightning1, MinLightning5, MinLightning9, MinLightning16:
+	default:
+		return fmt.Errorf("as3935: the config minimum number of lightning is out of range: %w", ErrValueOutOfRange)
+	}
+
+	if cfg.TuningCapacitance > Tuning120pF {
+		return fmt.Errorf("as3935: invalid config tuning capacitance value specified: %w", ErrValueOutOfRange)
+	}
+
+	switch cfg.IRQOutputSource {
+	case None, TRCO, SRCO, LCO:
+	default:
+		return fmt.Errorf("as3935: invalid config IRQ output source specified: %w", ErrValueOutOfRange)
+	}
+
+	return nil
+}
+
+func (m *module) ReadConfig() (Config, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.readConfig()
+}
+
+func (m *module) readConfig() (Config, error) {
+	registers, err := m.dumpRegisters()
+	if err != nil {
+		return Config{}, fmt.Errorf("as3935: failed to read the config registers: %w", err)
+	}
+
+	return decodeConfig(registers)
+}
+
+// Decode the configuration from the values of the registers from 0x00 to 0x08.
+func decodeConfig(registers [9]uint8) (Config, error) {
+	analogFrontEnd := AnalogFrontEnd(registers[RegisterPower] & 0x3E)
+	switch analogFrontEnd {
+	case Indoor, Outdoor:
+	default:
+		return Config{}, fmt.Errorf("as3935: the analog frontend had a corrupted value: %w", ErrCorruptedRegister)
+	}
+
+	watchdogThreshold := WatchdogThreshold(registers[RegisterNoiseFloor] & 0x0F)
+	if watchdogThreshold > WDTH10 {
+		return Config{}, fmt.Errorf("as3935: the watchdog threshold value had a corrupted value: %w", ErrCorruptedRegister)
+	}
+
+	spikeRejection := SpikeRejection(registers[RegisterStatistics] & 0x0F)
+	if spikeRejection > SREJ11 {
+		return Config{}, fmt.Errorf("as3935: the spike rejection had a corrupted value: %w", ErrCorruptedRegister)
+	}
+
+	irqOutputSource := IRQOutputSource(registers[RegisterTuning] & 0xE0)
+	switch irqOutputSource {
+	case None, TRCO, SRCO, LCO:
+	default:
+		return Config{}, fmt.Errorf("as3935: the irq output source had a corrupted value: %w", ErrCorruptedRegister)
+	}
+
+	return Config{
+		AnalogFrontEnd:       analogFrontEnd,
+		NoiseFloorLevel:      NoiseFloorLevel(registers[RegisterNoiseFloor] & 0x70),
+		WatchdogThreshold:    watchdogThreshold,
+		SpikeRejection:       spikeRejection,
+		MinNumberOfLightning: MinNumberOfLightning(registers[RegisterStatistics] & 0x30),
+		DisturberMasked:      registers[RegisterInterrupt]&0x20 != 0,
+		TuningCapacitance:    TuningCapacitance(registers[RegisterTuning] & 0x0F),
+		IRQOutputSource:      irqOutputSource,
+	}, nil
+}
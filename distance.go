@@ -0,0 +1,221 @@
+package as3935go
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type DistanceKind uint8
+
+const (
+	Estimated     DistanceKind = 0x00
+	StormOverhead DistanceKind = 0x01
+	OutOfRange    DistanceKind = 0x02
+)
+
+func (k DistanceKind) String() string {
+	switch k {
+	case Estimated:
+		return "Estimated"
+	case StormOverhead:
+		return "StormOverhead"
+	case OutOfRange:
+		return "OutOfRange"
+	default:
+		return fmt.Sprintf("DistanceKind(0x%02x)", uint8(k))
+	}
+}
+
+// The estimated distance of the storm/latest lightning. The Km value is only set for the Estimated kind.
+type DistanceEstimation struct {
+	Kind DistanceKind
+	Km   int
+}
+
+// The distance estimations in kilometers of the DISTANCE register values listed in the datasheet. The
+// datasheet encodes the distance as the kilometers directly, so the table matches the register values,
+// but the table documents which values are defined. The 0x01 (storm overhead) and 0x3F (out of range)
+// values are handled separately.
+var distanceTableKm = map[uint8]int{
+	0x05: 5,
+	0x06: 6,
+	0x08: 8,
+	0x0A: 10,
+	0x0C: 12,
+	0x0E: 14,
+	0x11: 17,
+	0x14: 20,
+	0x18: 24,
+	0x1B: 27,
+	0x1F: 31,
+	0x22: 34,
+	0x25: 37,
+	0x28: 40,
+}
+
+// Check if the DISTANCE register value is one of the values defined by the datasheet.
+func isDistanceDefined(register uint8) bool {
+	register &= 0x3F
+	if register == 0x01 || register == 0x3F {
+		return true
+	}
+
+	_, ok := distanceTableKm[register]
+	return ok
+}
+
+// Decode the distance estimation from the DISTANCE register value. The values not defined by the datasheet
+// are passed through as the kilometers, the getters are validating them with isDistanceDefined.
+func decodeDistanceEstimation(register uint8) DistanceEstimation {
+	register &= 0x3F
+
+	switch register {
+	case 0x01:
+		return DistanceEstimation{Kind: StormOverhead}
+	case 0x3F:
+		return DistanceEstimation{Kind: OutOfRange}
+	}
+
+	if km, ok := distanceTableKm[register]; ok {
+		return DistanceEstimation{Kind: Estimated, Km: km}
+	}
+
+	return DistanceEstimation{Kind: Estimated, Km: int(register)}
+}
+
+func (m *module) GetLightningEstimation() (DistanceEstimation, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if err := m.requireLightning(); err != nil {
+		return DistanceEstimation{}, err
+	}
+
+	return m.getLightningEstimation()
+}
+
+func (m *module) getLightningEstimation() (DistanceEstimation, error) {
+	register, err := m.i2c.RegRead(RegisterDistance)
+	if err != nil {
+		return DistanceEstimation{}, fmt.Errorf("as3935: failed to access the distance register: %w", err)
+	}
+
+	if !isDistanceDefined(register) {
+		return DistanceEstimation{}, fmt.Errorf("as3935: the distance had a corrupted value 0x%02x: %w", register&0x3F, ErrCorruptedRegister)
+	}
+
+	return decodeDistanceEstimation(register), nil
+}
+
+// The initial estimation is read before the function returns and is not sent to the channel. The strict
+// mode is not applied.
+func (m *module) WatchDistance(ctx context.Context, poll time.Duration) (<-chan DistanceEstimation, error) {
+	if poll <= 0 {
+		return nil, fmt.Errorf("as3935: the polling interval must be positive: %w", ErrValueOutOfRange)
+	}
+
+	estimate := func() (DistanceEstimation, error) {
+		if err := m.awaitPollSlot(ctx); err != nil {
+			return DistanceEstimation{}, err
+		}
+
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		return m.getLightningEstimation()
+	}
+
+	last, err := estimate()
+	if err != nil {
+		return nil, err
+	}
+
+	estimations := make(chan DistanceEstimation)
+
+	go func() {
+		defer close(estimations)
+
+		for {
+			if err := m.sleepContext(ctx, m.pollInterval(poll)); err != nil {
+				return
+			}
+
+			estimation, err := estimate()
+			if err != nil || estimation == last {
+				continue
+			}
+
+			last = estimation
+
+			select {
+			case estimations <- estimation:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return estimations, nil
+}
+
+// The number of the consecutive equal readings of the DISTANCE register treated as a stable estimation.
+const stableDistanceReadings = 3
+
+// The readings are evenly spread over the settle duration. The strict mode is not applied.
+func (m *module) ReadStableDistance(ctx context.Context, settle time.Duration) (DistanceEstimation, error) {
+	if settle <= 0 {
+		return DistanceEstimation{}, fmt.Errorf("as3935: the settle duration must be positive: %w", ErrValueOutOfRange)
+	}
+
+	estimate := func() (DistanceEstimation, error) {
+		if err := m.awaitPollSlot(ctx); err != nil {
+			return DistanceEstimation{}, err
+		}
+
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		return m.getLightningEstimation()
+	}
+
+	var (
+		poll     = settle / (stableDistanceReadings - 1)
+		last     DistanceEstimation
+		readings = 0
+	)
+
+	for {
+		estimation, err := estimate()
+		if err != nil {
+			return DistanceEstimation{}, err
+		}
+
+		if readings == 0 || estimation != last {
+			last, readings = estimation, 0
+		}
+
+		readings += 1
+		if readings >= stableDistanceReadings {
+			return last, nil
+		}
+
+		if err := m.sleepContext(ctx, m.pollInterval(poll)); err != nil {
+			return DistanceEstimation{}, fmt.Errorf("as3935: the distance has not settled: %w", err)
+		}
+	}
+}
+
+// Check if the last interrupt read via the module is a lightning when the strict mode is enabled. The INT
+// register is not read again, because reading it clears the interrupt.
+func (m *module) requireLightning() error {
+	if !m.options.strict {
+		return nil
+	}
+
+	if interrupt := InterruptType(m.lastInterrupt.Load()); interrupt != LightningInterrupt {
+		return fmt.Errorf("as3935: the last interrupt %s is not a lightning: %w", interrupt, ErrNoLightningEvent)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package as3935go
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"testing"
+	"time"
+)
+
+// The observer counting the observed interrupt types.
+type interruptCountingObserver struct {
+	interrupts map[InterruptType]int
+	mu         sync.Mutex
+}
+
+func (o *interruptCountingObserver) ObserveInterrupt(interrupt InterruptType) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.interrupts[interrupt] += 1
+}
+
+func (o *interruptCountingObserver) ObserveStrike(event StrikeEvent)              {}
+func (o *interruptCountingObserver) ObserveNoiseFloorLevel(level NoiseFloorLevel) {}
+func (o *interruptCountingObserver) ObserveBusError(err error)                    {}
+
+func TestGetLightningDistanceKm(t *testing.T) {
+	m := openMockModule(t)
+
+	cases := map[uint8]int{
+		0x01: 0,
+		0x05: 5,
+		0x06: 6,
+		0x08: 8,
+		0x0A: 10,
+		0x0C: 12,
+		0x0E: 14,
+		0x11: 17,
+		0x14: 20,
+		0x18: 24,
+		0x1B: 27,
+		0x1F: 31,
+		0x22: 34,
+		0x25: 37,
+		0x28: 40,
+		0x3F: math.MaxInt,
+	}
+
+	for register, expected := range cases {
+		// NOTE: The reserved bits are set to verify they are masked out
+		m.SetRegister(RegisterDistance, register|0xC0)
+
+		actual, err := m.GetLightningDistanceKm()
+		if err != nil {
+			t.Fatalf("failed to get the distance for %#02x: %s", register, err)
+		}
+
+		if actual != expected {
+			t.Fatalf("expected the distance %d for %#02x, got %d", expected, register, actual)
+		}
+	}
+
+	for _, register := range []uint8{0x00, 0x02, 0x07, 0x29, 0x3E} {
+		m.SetRegister(RegisterDistance, register)
+
+		if _, err := m.GetLightningDistanceKm(); !errors.Is(err, ErrCorruptedRegister) {
+			t.Fatalf("expected the corrupted register error for %#02x, got %v", register, err)
+		}
+	}
+}
+
+func TestStrictModeUsesTheLastInterrupt(t *testing.T) {
+	observer := &interruptCountingObserver{interrupts: make(map[InterruptType]int)}
+	m := openMockModule(t, WithStrictMode(), WithObserver(observer), WithClock(NewFakeClock(time.Unix(0, 0))))
+
+	if _, err := m.GetLightningDistanceKm(); !errors.Is(err, ErrNoLightningEvent) {
+		t.Fatalf("expected the no lightning event error before the interrupt read, got %v", err)
+	}
+
+	m.InjectEvent(StrikeEvent{Type: LightningInterrupt, DistanceKm: 14, Energy: scaleStrikeEnergy(0x123456)})
+	if interrupt, err := m.GetInterruptSource(); err != nil || interrupt != LightningInterrupt {
+		t.Fatalf("expected the lightning interrupt, got %s and %v", interrupt, err)
+	}
+
+	// NOTE: The INT register is cleared on read by the chip
+	m.SetRegister(RegisterInterrupt, m.GetRegister(RegisterInterrupt)&0xF0)
+
+	if distance, err := m.GetLightningDistanceKm(); err != nil || distance != 14 {
+		t.Fatalf("expected the 14 km distance, got %d and %v", distance, err)
+	}
+
+	if energy, err := m.GetStrikeEnergyRaw(); err != nil || energy != 0x123456 {
+		t.Fatalf("expected the 0x123456 strike energy, got %#06x and %v", energy, err)
+	}
+
+	if count := observer.interrupts[LightningInterrupt]; count != 1 {
+		t.Fatalf("expected the lightning to be observed once, got %d", count)
+	}
+
+	m.InjectEvent(StrikeEvent{Type: DisturberDetected})
+	if _, err := m.GetInterruptSource(); err != nil {
+		t.Fatalf("failed to get the interrupt source: %s", err)
+	}
+
+	if _, err := m.GetStrikeEnergy(); !errors.Is(err, ErrNoLightningEvent) {
+		t.Fatalf("expected the no lightning event error after the disturber, got %v", err)
+	}
+}
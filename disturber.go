@@ -0,0 +1,186 @@
+package as3935go
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// The target of the disturber rejection optimization.
+type DisturberProfile struct {
+	// The maximum accepted rate of the DisturberDetected interrupts per minute.
+	MaxDisturberRate float64
+
+	// The duration of the observation of a single SREJ and WDTH combination.
+	Window time.Duration
+
+	// The interval of the interrupt register polling during the observation.
+	Poll time.Duration
+}
+
+// The result of the disturber rejection optimization.
+type DisturberRejectionResult struct {
+	SpikeRejection    SpikeRejection
+	WatchdogThreshold WatchdogThreshold
+
+	// The observed rate of the DisturberDetected interrupts per minute with the chosen combination.
+	DisturberRate float64
+
+	// The observed rate of the LightningInterrupt interrupts per minute with the chosen combination.
+	LightningRate float64
+}
+
+// Starting from the current values, the SREJ and WDTH are incremented alternately, beginning with WDTH, and
+// every combination is observed for the profile window. The optimization stops at the first combination with
+// the disturber rate not exceeding the target. If a combination reports no lightning while the previous one
+// did, the lightning is considered suppressed and the previous combination is chosen instead. The chosen
+// combination is left applied, the original values are restored if the context is done or an error occurs.
+func (m *module) OptimizeDisturberRejection(ctx context.Context, target DisturberProfile) (DisturberRejectionResult, error) {
+	if target.Window <= 0 || target.Poll <= 0 || target.MaxDisturberRate < 0 {
+		return DisturberRejectionResult{}, fmt.Errorf("as3935: invalid disturber profile specified: %w", ErrValueOutOfRange)
+	}
+
+	watchdog, err := m.GetWatchdogThreshold()
+	if err != nil {
+		return DisturberRejectionResult{}, fmt.Errorf("as3935: failed to read the original watchdog threshold: %w", err)
+	}
+
+	rejection, err := m.GetSpikeRejection()
+	if err != nil {
+		return DisturberRejectionResult{}, fmt.Errorf("as3935: failed to read the original spike rejection: %w", err)
+	}
+
+	original := DisturberRejectionResult{
+		SpikeRejection:    SpikeRejection(rejection),
+		WatchdogThreshold: WatchdogThreshold(watchdog),
+	}
+
+	result, err := m.optimizeDisturberRejection(ctx, target, original)
+	if err != nil {
+		if restoreErr := m.applyDisturberRejection(original); restoreErr != nil {
+			return DisturberRejectionResult{}, fmt.Errorf("as3935: failed to restore the original disturber rejection: %w", restoreErr)
+		}
+
+		return DisturberRejectionResult{}, err
+	}
+
+	return result, nil
+}
+
+func (m *module) optimizeDisturberRejection(ctx context.Context, target DisturberProfile, current DisturberRejectionResult) (DisturberRejectionResult, error) {
+	var (
+		previous    *DisturberRejectionResult = nil
+		incrementWd                           = true
+	)
+
+	for {
+		if err := m.applyDisturberRejection(current); err != nil {
+			return DisturberRejectionResult{}, err
+		}
+
+		disturbers, lightnings, err := m.countInterrupts(ctx, target.Window, target.Poll)
+		if err != nil {
+			return DisturberRejectionResult{}, err
+		}
+
+		current.DisturberRate = float64(disturbers) / target.Window.Minutes()
+		current.LightningRate = float64(lightnings) / target.Window.Minutes()
+
+		if previous != nil && previous.LightningRate > 0 && current.LightningRate == 0 {
+			return *previous, m.applyDisturberRejection(*previous)
+		}
+
+		if current.DisturberRate <= target.MaxDisturberRate {
+			return current, nil
+		}
+
+		canIncrementWd := current.WatchdogThreshold < WDTH10
+		canIncrementSrej := current.SpikeRejection < SREJ11
+
+		if !canIncrementWd && !canIncrementSrej {
+			return current, nil
+		}
+
+		last := current
+		previous = &last
+
+		if (incrementWd && canIncrementWd) || !canIncrementSrej {
+			current.WatchdogThreshold += 1
+		} else {
+			current.SpikeRejection += 1
+		}
+
+		incrementWd = !incrementWd
+	}
+}
+
+func (m *module) applyDisturberRejection(result DisturberRejectionResult) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.setWatchdogThreshold(result.WatchdogThreshold); err != nil {
+		return err
+	}
+
+	return m.setSpikeRejection(result.SpikeRejection)
+}
+
+// All values are validated before the first write. The WDTH is written to the 0x01 register and the SREJ
+// together with the MIN_NUM_LIGH are written to the 0x02 register with a single masked write.
+func (m *module) SetDisturberTuning(threshold WatchdogThreshold, rejection SpikeRejection, minimum MinNumberOfLightning) error {
+	if uint8(threshold) > 0x0A {
+		return fmt.Errorf("as3935: the provided watchdog threshold value is out of range: %w", ErrValueOutOfRange)
+	}
+
+	if uint8(rejection) > 0x0B {
+		return fmt.Errorf("as3935: the specified spike rejection is out of range: %w", ErrValueOutOfRange)
+	}
+
+	switch minimum {
+	case MinLightning1, MinLightning5, MinLightning9, MinLightning16:
+	default:
+		return fmt.Errorf("as3935: the specified minimum number of lightning is out of range: %w", ErrValueOutOfRange)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.i2c.RegWriteMasked(RegisterNoiseFloor, uint8(threshold), 0x0F); err != nil {
+		return fmt.Errorf("as3935: failed to set the watchdog threshold register: %w", err)
+	}
+
+	if err := m.i2c.RegWriteMasked(RegisterStatistics, uint8(minimum)|uint8(rejection), 0x3F); err != nil {
+		return fmt.Errorf("as3935: failed to set the spike rejection and minimum number of lightning register: %w", err)
+	}
+
+	return nil
+}
+
+// Poll the interrupt register for the window duration and count the disturber and lightning interrupts.
+func (m *module) countInterrupts(ctx context.Context, window, poll time.Duration) (disturbers int, lightnings int, err error) {
+	deadline := m.options.clock.Now().Add(window)
+
+	for m.options.clock.Now().Before(deadline) {
+		if err := m.sleepContext(ctx, m.pollInterval(poll)); err != nil {
+			return 0, 0, err
+		}
+
+		if err := m.awaitPollSlot(ctx); err != nil {
+			return 0, 0, err
+		}
+
+		interrupt, err := m.GetInterruptSourceContext(ctx)
+		if err != nil {
+			return 0, 0, fmt.Errorf("as3935: failed to read the interrupt during the disturber rejection optimization: %w", err)
+		}
+
+		switch interrupt {
+		case DisturberDetected:
+			disturbers += 1
+		case LightningInterrupt:
+			lightnings += 1
+		}
+	}
+
+	return disturbers, lightnings, nil
+}
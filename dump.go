@@ -0,0 +1,178 @@
+package as3935go
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// The values of the registers from 0x00 to 0x08 decoded into the individual fields. The decoded fields are
+// derived from the raw Registers values and are not validated, which allows to inspect corrupted values.
+// The distance is decoded via the datasheet lookup table and the energy is assembled from the three registers.
+type RegisterDump struct {
+	Registers            [9]uint8
+	AnalogFrontEnd       AnalogFrontEnd
+	PoweredUp            bool
+	NoiseFloorLevel      NoiseFloorLevel
+	WatchdogThreshold    WatchdogThreshold
+	SpikeRejection       SpikeRejection
+	MinNumberOfLightning MinNumberOfLightning
+	StatisticsCleared    bool
+	DisturberMasked      bool
+	FrequencyDivision    FrequencyDivision
+	InterruptType        InterruptType
+	Distance             DistanceEstimation
+	DistanceKm           int
+	EnergyRaw            uint32
+	Energy               float64
+	TuningCapacitance    TuningCapacitance
+	IRQOutputSource      IRQOutputSource
+}
+
+// Decode the values of the registers from 0x00 to 0x08 into the register dump.
+func DecodeRegisterDump(registers [9]uint8) RegisterDump {
+	energyRaw := decodeStrikeEnergy(registers[RegisterEnergyL], registers[RegisterEnergyM], registers[RegisterEnergyMM])
+
+	return RegisterDump{
+		Registers:            registers,
+		AnalogFrontEnd:       AnalogFrontEnd(registers[RegisterPower] & 0x3E),
+		PoweredUp:            registers[RegisterPower]&0x01 == 0,
+		NoiseFloorLevel:      NoiseFloorLevel(registers[RegisterNoiseFloor] & 0x70),
+		WatchdogThreshold:    WatchdogThreshold(registers[RegisterNoiseFloor] & 0x0F),
+		SpikeRejection:       SpikeRejection(registers[RegisterStatistics] & 0x0F),
+		MinNumberOfLightning: MinNumberOfLightning(registers[RegisterStatistics] & 0x30),
+		StatisticsCleared:    registers[RegisterStatistics]&0x40 != 0,
+		DisturberMasked:      registers[RegisterInterrupt]&0x20 != 0,
+		FrequencyDivision:    FrequencyDivision(registers[RegisterInterrupt] & 0xC0),
+		InterruptType:        InterruptType(registers[RegisterInterrupt] & 0x0F),
+		Distance:             decodeDistanceEstimation(registers[RegisterDistance]),
+		DistanceKm:           decodeDistanceKm(registers[RegisterDistance]),
+		EnergyRaw:            energyRaw,
+		Energy:               scaleStrikeEnergy(energyRaw),
+		TuningCapacitance:    TuningCapacitance(registers[RegisterTuning] & 0x0F),
+		IRQOutputSource:      IRQOutputSource(registers[RegisterTuning] & 0xE0),
+	}
+}
+
+// The JSON representation of the register dump. The "Out of range" distance is represented as null.
+type registerDumpJSON struct {
+	Registers            [9]uint8 `json:"registers"`
+	AnalogFrontEnd       string   `json:"analog_front_end"`
+	PoweredUp            bool     `json:"powered_up"`
+	NoiseFloorLevel      uint8    `json:"noise_floor_level"`
+	WatchdogThreshold    uint8    `json:"watchdog_threshold"`
+	SpikeRejection       uint8    `json:"spike_rejection"`
+	MinNumberOfLightning int      `json:"min_number_of_lightning"`
+	StatisticsCleared    bool     `json:"statistics_cleared"`
+	DisturberMasked      bool     `json:"disturber_masked"`
+	FrequencyDivision    int      `json:"frequency_division"`
+	InterruptType        string   `json:"interrupt_type"`
+	DistanceKind         string   `json:"distance_kind"`
+	DistanceKm           *int     `json:"distance_km"`
+	EnergyRaw            uint32   `json:"energy_raw"`
+	Energy               float64  `json:"energy"`
+	TuningCapacitancePF  int      `json:"tuning_capacitance_pf"`
+	IRQOutputSource      string   `json:"irq_output_source"`
+}
+
+func (d RegisterDump) MarshalJSON() ([]byte, error) {
+	var distanceKm *int = nil
+	if d.DistanceKm != math.MaxInt {
+		distance := d.DistanceKm
+		distanceKm = &distance
+	}
+
+	return json.Marshal(registerDumpJSON{
+		Registers:            d.Registers,
+		AnalogFrontEnd:       d.AnalogFrontEnd.String(),
+		PoweredUp:            d.PoweredUp,
+		NoiseFloorLevel:      uint8(d.NoiseFloorLevel) >> 4,
+		WatchdogThreshold:    uint8(d.WatchdogThreshold),
+		SpikeRejection:       uint8(d.SpikeRejection),
+		MinNumberOfLightning: int(d.MinNumberOfLightning.Count()),
+		StatisticsCleared:    d.StatisticsCleared,
+		DisturberMasked:      d.DisturberMasked,
+		FrequencyDivision:    d.FrequencyDivision.Ratio(),
+		InterruptType:        d.InterruptType.String(),
+		DistanceKind:         d.Distance.Kind.String(),
+		DistanceKm:           distanceKm,
+		EnergyRaw:            d.EnergyRaw,
+		Energy:               d.Energy,
+		TuningCapacitancePF:  int(d.TuningCapacitance&0x0F) * 8,
+		IRQOutputSource:      d.IRQOutputSource.String(),
+	})
+}
+
+// The register dump is restored from the raw registers values and the decoded fields are derived from them.
+func (d *RegisterDump) UnmarshalJSON(data []byte) error {
+	var dump registerDumpJSON
+	if err := json.Unmarshal(data, &dump); err != nil {
+		return fmt.Errorf("as3935: failed to unmarshal the register dump: %w", err)
+	}
+
+	*d = DecodeRegisterDump(dump.Registers)
+	return nil
+}
+
+func (m *module) DumpRegistersDecoded() (RegisterDump, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	registers, err := m.dumpRegisters()
+	if err != nil {
+		return RegisterDump{}, err
+	}
+
+	return DecodeRegisterDump(registers), nil
+}
+
+// Format the values of the registers from 0x00 to 0x08 as a labeled text with one register field per line.
+// The fields are not validated, so the corrupted values are rendered with their raw representation.
+func FormatDump(registers [9]uint8) string {
+	var (
+		dump    = DecodeRegisterDump(registers)
+		builder = strings.Builder{}
+	)
+
+	line := func(label string, format string, args ...any) {
+		fmt.Fprintf(&builder, "%-14s "+format+"\n", append([]any{label + ":"}, args...)...)
+	}
+
+	line("REGISTERS", "% 02x", registers[:])
+	line("AFE_GB", "%s", dump.AnalogFrontEnd)
+	line("PWD", "%t (powered up: %t)", !dump.PoweredUp, dump.PoweredUp)
+	line("NF_LEV", "%s", dump.NoiseFloorLevel)
+	line("WDTH", "%s", dump.WatchdogThreshold)
+	line("CL_STAT", "%t", dump.StatisticsCleared)
+	line("MIN_NUM_LIGH", "%s", dump.MinNumberOfLightning)
+	line("SREJ", "%s", dump.SpikeRejection)
+	line("LCO_FDIV", "%s", dump.FrequencyDivision)
+	line("MASK_DIST", "%t", dump.DisturberMasked)
+	line("INT", "%s", dump.InterruptType)
+
+	switch dump.Distance.Kind {
+	case Estimated:
+		line("DISTANCE", "%d km", dump.Distance.Km)
+	default:
+		line("DISTANCE", "%s", dump.Distance.Kind)
+	}
+
+	line("S_LIG", "%d (0x%06x)", dump.EnergyRaw, dump.EnergyRaw)
+	line("DISP_IRQ", "%s", dump.IRQOutputSource)
+	line("TUN_CAP", "%s", dump.TuningCapacitance)
+
+	return builder.String()
+}
+
+func (m *module) DumpRegistersString() (string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	registers, err := m.dumpRegisters()
+	if err != nil {
+		return "", err
+	}
+
+	return FormatDump(registers), nil
+}
@@ -0,0 +1,77 @@
+package as3935go
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// The representative register set: outdoor and powered up, lightning at 14 km with the disturbers masked,
+// the LCO displayed on the IRQ pin and the 40pF tuning capacitance.
+var goldenRegisters = [9]uint8{0x1C, 0x22, 0xC2, 0x68, 0x56, 0x34, 0x12, 0x0E, 0x85}
+
+// Compare the actual value with the golden file contents, the file is rewritten when the update flag is set.
+func assertGolden(t *testing.T, name string, actual []byte) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, actual, 0o644); err != nil {
+			t.Fatalf("failed to update the golden file: %s", err)
+		}
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read the golden file: %s", err)
+	}
+
+	if string(actual) != string(expected) {
+		t.Fatalf("expected the %s golden file contents:\n%s\ngot:\n%s", name, expected, actual)
+	}
+}
+
+func TestDumpRegistersDecodedGolden(t *testing.T) {
+	m := openMockModule(t)
+	for offset, value := range goldenRegisters {
+		m.SetRegister(uint8(offset), value)
+	}
+
+	dump, err := m.DumpRegistersDecoded()
+	if err != nil {
+		t.Fatalf("failed to dump the registers: %s", err)
+	}
+
+	if dump.Distance.Km != 14 || dump.DistanceKm != 14 {
+		t.Fatalf("expected the 14 km distance, got %v and %d", dump.Distance, dump.DistanceKm)
+	}
+
+	if dump.EnergyRaw != 0x123456 {
+		t.Fatalf("expected the raw energy 0x123456, got %#06x", dump.EnergyRaw)
+	}
+
+	data, err := json.MarshalIndent(dump, "", "  ")
+	if err != nil {
+		t.Fatalf("failed to marshal the register dump: %s", err)
+	}
+
+	assertGolden(t, "dump.golden.json", append(data, '\n'))
+
+	var restored RegisterDump
+	if err := json.Unmarshal(data, &restored); err != nil {
+		t.Fatalf("failed to unmarshal the register dump: %s", err)
+	}
+
+	if !reflect.DeepEqual(restored, dump) {
+		t.Fatalf("expected the restored dump %+v, got %+v", dump, restored)
+	}
+}
+
+func TestFormatDumpGolden(t *testing.T) {
+	assertGolden(t, "dump.golden.txt", []byte(FormatDump(goldenRegisters)))
+}
@@ -0,0 +1,50 @@
+package as3935go
+
+import (
+	"errors"
+
+	"github.com/Krzysztofz01/as3935-go/internal"
+)
+
+// The sentinel errors wrapped by the module errors, which can be checked with errors.Is.
+var (
+	// The operation requires an opened connection to the module.
+	ErrNotConnected = internal.ErrNotConnected
+
+	// The connection to the module is already opened.
+	ErrAlreadyConnected = internal.ErrAlreadyConnected
+
+	// The provided value is out of the range supported by the module.
+	ErrValueOutOfRange = internal.ErrValueOutOfRange
+
+	// The value read from the register does not correspond to any valid value.
+	ErrCorruptedRegister = internal.ErrCorruptedRegister
+
+	// The communication with the module over the bus has failed. The operation can be retried.
+	ErrBusFailure = internal.ErrBusFailure
+
+	// The communication with the module over the bus has not completed within the operation timeout.
+	ErrBusTimeout = internal.ErrBusTimeout
+
+	// The value read back from the register does not match the written value.
+	ErrWriteVerifyFailed = internal.ErrWriteVerifyFailed
+)
+
+// The module is not responding as the AS3935 sensor at the configured address.
+var ErrNoDevice = errors.New("no device responding")
+
+// The calibration of the module oscillators has been reported as not successful.
+var ErrCalibrationFailed = errors.New("calibration failed")
+
+// The interrupt handling is already started with Start and has not been stopped yet.
+var ErrAlreadyStarted = errors.New("already started")
+
+// The encoded strike event holds a value which can not be produced by EncodeEvent from a module event.
+var ErrCorruptedEvent = errors.New("corrupted event")
+
+// The last interrupt is not a lightning, so the distance and energy registers are stale. Returned in the strict mode.
+var ErrNoLightningEvent = errors.New("no lightning event")
+
+// The error of a register operation carrying the register offset and the operation ("read" or "write"),
+// which can be retrieved from the module errors with errors.As.
+type RegisterError = internal.RegisterError
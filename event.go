@@ -0,0 +1,78 @@
+package as3935go
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+// The size of the encoded strike event: the type (1 byte), the distance (8 bytes), the energy (8 bytes)
+// and the timestamp as unix nanoseconds (8 bytes), all in little-endian.
+const EncodedEventSize int = 25
+
+// Encode the strike event into the writer using the fixed little-endian layout. The zero timestamp is
+// encoded as zero unix nanoseconds.
+func EncodeEvent(w io.Writer, e StrikeEvent) error {
+	var (
+		buffer    [EncodedEventSize]byte
+		timestamp int64 = 0
+	)
+
+	if !e.Timestamp.IsZero() {
+		timestamp = e.Timestamp.UnixNano()
+	}
+
+	buffer[0] = uint8(e.Type)
+	binary.LittleEndian.PutUint64(buffer[1:9], uint64(int64(e.DistanceKm)))
+	binary.LittleEndian.PutUint64(buffer[9:17], math.Float64bits(e.Energy))
+	binary.LittleEndian.PutUint64(buffer[17:25], uint64(timestamp))
+
+	if _, err := w.Write(buffer[:]); err != nil {
+		return fmt.Errorf("as3935: failed to write the encoded strike event: %w", err)
+	}
+
+	return nil
+}
+
+// Decode the strike event encoded with EncodeEvent from the reader. The io.EOF error is returned unwrapped
+// if the reader has no more events. The events with an unknown type, a negative distance or an invalid
+// energy are rejected with the ErrCorruptedEvent error.
+func DecodeEvent(r io.Reader) (StrikeEvent, error) {
+	var buffer [EncodedEventSize]byte
+
+	if _, err := io.ReadFull(r, buffer[:]); err != nil {
+		if err == io.EOF {
+			return StrikeEvent{}, err
+		}
+
+		return StrikeEvent{}, fmt.Errorf("as3935: failed to read the encoded strike event: %w", err)
+	}
+
+	event := StrikeEvent{
+		Type:       InterruptType(buffer[0]),
+		DistanceKm: int(int64(binary.LittleEndian.Uint64(buffer[1:9]))),
+		Energy:     math.Float64frombits(binary.LittleEndian.Uint64(buffer[9:17])),
+	}
+
+	switch event.Type {
+	case NoResults, NoiseLevelTooHigh, DisturberDetected, LightningInterrupt, InterruptUnknown:
+	default:
+		return StrikeEvent{}, fmt.Errorf("as3935: the encoded strike event has an unknown type 0x%02x: %w", buffer[0], ErrCorruptedEvent)
+	}
+
+	if event.DistanceKm < 0 {
+		return StrikeEvent{}, fmt.Errorf("as3935: the encoded strike event has a negative distance: %w", ErrCorruptedEvent)
+	}
+
+	if math.IsNaN(event.Energy) || math.IsInf(event.Energy, 0) || event.Energy < 0 {
+		return StrikeEvent{}, fmt.Errorf("as3935: the encoded strike event has an invalid energy: %w", ErrCorruptedEvent)
+	}
+
+	if timestamp := int64(binary.LittleEndian.Uint64(buffer[17:25])); timestamp != 0 {
+		event.Timestamp = time.Unix(0, timestamp)
+	}
+
+	return event, nil
+}
@@ -0,0 +1,106 @@
+package as3935go
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestEncodeDecodeEventRoundTrip(t *testing.T) {
+	events := []StrikeEvent{
+		{Type: LightningInterrupt, DistanceKm: 14, Energy: 0.071, Timestamp: time.Unix(1700000000, 123456789)},
+		{Type: LightningInterrupt, DistanceKm: 0, Energy: 0.125, Timestamp: time.Unix(0, 1)},
+		{Type: LightningInterrupt, DistanceKm: math.MaxInt, Energy: 0},
+		{Type: DisturberDetected},
+		{Type: NoiseLevelTooHigh, Timestamp: time.Unix(-1, 0)},
+	}
+
+	var buffer bytes.Buffer
+	for _, event := range events {
+		if err := EncodeEvent(&buffer, event); err != nil {
+			t.Fatalf("failed to encode the event: %s", err)
+		}
+	}
+
+	if buffer.Len() != len(events)*EncodedEventSize {
+		t.Fatalf("expected %d encoded bytes, got %d", len(events)*EncodedEventSize, buffer.Len())
+	}
+
+	for _, expected := range events {
+		actual, err := DecodeEvent(&buffer)
+		if err != nil {
+			t.Fatalf("failed to decode the event: %s", err)
+		}
+
+		if actual.Type != expected.Type || actual.DistanceKm != expected.DistanceKm || actual.Energy != expected.Energy || !actual.Timestamp.Equal(expected.Timestamp) {
+			t.Fatalf("expected the decoded event %+v, got %+v", expected, actual)
+		}
+	}
+
+	if _, err := DecodeEvent(&buffer); err != io.EOF {
+		t.Fatalf("expected the unwrapped end of file error, got %v", err)
+	}
+}
+
+func TestDecodeEventTruncatedInput(t *testing.T) {
+	var buffer bytes.Buffer
+	if err := EncodeEvent(&buffer, StrikeEvent{Type: LightningInterrupt, DistanceKm: 14}); err != nil {
+		t.Fatalf("failed to encode the event: %s", err)
+	}
+
+	truncated := bytes.NewReader(buffer.Bytes()[:EncodedEventSize-1])
+	if _, err := DecodeEvent(truncated); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("expected the unexpected end of file error, got %v", err)
+	}
+}
+
+func TestDecodeEventCorruptedInput(t *testing.T) {
+	events := []StrikeEvent{
+		{Type: InterruptType(0x05)},
+		{Type: LightningInterrupt, DistanceKm: -1},
+		{Type: LightningInterrupt, Energy: math.NaN()},
+		{Type: LightningInterrupt, Energy: math.Inf(1)},
+		{Type: LightningInterrupt, Energy: -0.5},
+	}
+
+	for _, event := range events {
+		var buffer bytes.Buffer
+		if err := EncodeEvent(&buffer, event); err != nil {
+			t.Fatalf("failed to encode the event: %s", err)
+		}
+
+		if _, err := DecodeEvent(&buffer); !errors.Is(err, ErrCorruptedEvent) {
+			t.Fatalf("expected the corrupted event error for %+v, got %v", event, err)
+		}
+	}
+}
+
+func TestReplayedEventThroughInjectEvent(t *testing.T) {
+	m := openMockModule(t, WithClock(NewFakeClock(time.Unix(0, 0))))
+
+	recorded := StrikeEvent{Type: LightningInterrupt, DistanceKm: 14, Energy: scaleStrikeEnergy(0x123456), Timestamp: time.Unix(1700000000, 0)}
+
+	var buffer bytes.Buffer
+	if err := EncodeEvent(&buffer, recorded); err != nil {
+		t.Fatalf("failed to encode the event: %s", err)
+	}
+
+	replayed, err := DecodeEvent(&buffer)
+	if err != nil {
+		t.Fatalf("failed to decode the event: %s", err)
+	}
+
+	m.InjectEvent(replayed)
+
+	event, err := m.ReadStrikeEvent()
+	if err != nil {
+		t.Fatalf("failed to read the strike event: %s", err)
+	}
+
+	if event.Type != recorded.Type || event.DistanceKm != recorded.DistanceKm || event.Energy != recorded.Energy {
+		t.Fatalf("expected the replayed event %+v, got %+v", recorded, event)
+	}
+}
@@ -0,0 +1,90 @@
+package as3935go
+
+import (
+	"math"
+	"time"
+)
+
+// The time window in which the strike reports of multiple modules are considered to describe the same lightning.
+const FuseWindow time.Duration = time.Second
+
+// The distance estimation combined from the strike reports of multiple modules.
+type FusedEstimate struct {
+	// The energy weighted distance in kilometers. Zero when the storm is overhead.
+	DistanceKm float64
+
+	// The confidence of the estimate in the range from 0.0 to 1.0. Zero when no report was usable.
+	Confidence float64
+
+	// The number of reports which contributed to the estimate.
+	Count int
+}
+
+// Combine the simultaneous strike reports of multiple modules into a single distance estimate.
+//
+// The reports which are not lightning interrupts (disturbers, noise) and the reports with an out of range
+// distance are discarded, as well as the reports older than FuseWindow relative to the latest lightning report.
+// The distance is the average of the remaining reports weighted by the energy, because a higher energy
+// suggests the module is closer to the lightning and its estimate is more accurate. When all energies are
+// zero, the reports are weighted equally. The confidence is the fraction of the provided reports that were
+// used, reduced by the weighted standard deviation of the distances relative to the estimated distance,
+// so the discarded reports or disagreeing modules result in a lower confidence. The number of the reports
+// does not affect the confidence, a single usable report without any discarded ones results in 1.0.
+func FuseEvents(events []StrikeEvent) FusedEstimate {
+	latest := time.Time{}
+	for _, event := range events {
+		if isFusable(event) && event.Timestamp.After(latest) {
+			latest = event.Timestamp
+		}
+	}
+
+	var (
+		used        = make([]StrikeEvent, 0, len(events))
+		totalEnergy = 0.0
+	)
+
+	for _, event := range events {
+		if !isFusable(event) || latest.Sub(event.Timestamp) > FuseWindow {
+			continue
+		}
+
+		used = append(used, event)
+		totalEnergy += event.Energy
+	}
+
+	if len(used) == 0 {
+		return FusedEstimate{}
+	}
+
+	weight := func(event StrikeEvent) float64 {
+		if totalEnergy <= 0 {
+			return 1.0 / float64(len(used))
+		}
+
+		return event.Energy / totalEnergy
+	}
+
+	distance := 0.0
+	for _, event := range used {
+		distance += weight(event) * float64(event.DistanceKm)
+	}
+
+	variance := 0.0
+	for _, event := range used {
+		deviation := float64(event.DistanceKm) - distance
+		variance += weight(event) * deviation * deviation
+	}
+
+	coverage := float64(len(used)) / float64(len(events))
+	agreement := 1.0 / (1.0 + math.Sqrt(variance)/math.Max(distance, 1.0))
+
+	return FusedEstimate{
+		DistanceKm: distance,
+		Confidence: coverage * agreement,
+		Count:      len(used),
+	}
+}
+
+func isFusable(event StrikeEvent) bool {
+	return event.Type == LightningInterrupt && event.DistanceKm >= 0 && event.DistanceKm != math.MaxInt
+}
@@ -0,0 +1,81 @@
+package as3935go
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestFuseEventsWeightsDistanceByEnergy(t *testing.T) {
+	now := time.Now()
+	estimate := FuseEvents([]StrikeEvent{
+		{Type: LightningInterrupt, Timestamp: now, DistanceKm: 10, Energy: 0.03},
+		{Type: LightningInterrupt, Timestamp: now, DistanceKm: 20, Energy: 0.01},
+	})
+
+	if estimate.Count != 2 {
+		t.Fatalf("expected 2 used reports, got %d", estimate.Count)
+	}
+
+	if math.Abs(estimate.DistanceKm-12.5) > 1e-9 {
+		t.Fatalf("expected the distance 12.5 km, got %f", estimate.DistanceKm)
+	}
+
+	if estimate.Confidence <= 0 || estimate.Confidence >= 1 {
+		t.Fatalf("expected the confidence of disagreeing reports in (0, 1), got %f", estimate.Confidence)
+	}
+}
+
+func TestFuseEventsWeightsEquallyWithoutEnergy(t *testing.T) {
+	now := time.Now()
+	estimate := FuseEvents([]StrikeEvent{
+		{Type: LightningInterrupt, Timestamp: now, DistanceKm: 10},
+		{Type: LightningInterrupt, Timestamp: now, DistanceKm: 20},
+	})
+
+	if math.Abs(estimate.DistanceKm-15) > 1e-9 {
+		t.Fatalf("expected the distance 15 km, got %f", estimate.DistanceKm)
+	}
+}
+
+func TestFuseEventsDiscardsUnusableReports(t *testing.T) {
+	now := time.Now()
+	estimate := FuseEvents([]StrikeEvent{
+		{Type: LightningInterrupt, Timestamp: now, DistanceKm: 14, Energy: 0.02},
+		{Type: DisturberDetected, Timestamp: now, DistanceKm: 1, Energy: 0.1},
+		{Type: LightningInterrupt, Timestamp: now, DistanceKm: math.MaxInt, Energy: 0.1},
+		{Type: LightningInterrupt, Timestamp: now.Add(-2 * FuseWindow), DistanceKm: 40, Energy: 0.1},
+	})
+
+	if estimate.Count != 1 {
+		t.Fatalf("expected 1 used report, got %d", estimate.Count)
+	}
+
+	if estimate.DistanceKm != 14 {
+		t.Fatalf("expected the distance 14 km, got %f", estimate.DistanceKm)
+	}
+
+	if math.Abs(estimate.Confidence-0.25) > 1e-9 {
+		t.Fatalf("expected the confidence 0.25, got %f", estimate.Confidence)
+	}
+}
+
+func TestFuseEventsSingleReportHasFullConfidence(t *testing.T) {
+	estimate := FuseEvents([]StrikeEvent{
+		{Type: LightningInterrupt, Timestamp: time.Now(), DistanceKm: 6, Energy: 0.01},
+	})
+
+	if estimate.Confidence != 1.0 {
+		t.Fatalf("expected the confidence 1.0, got %f", estimate.Confidence)
+	}
+}
+
+func TestFuseEventsWithoutUsableReports(t *testing.T) {
+	estimate := FuseEvents([]StrikeEvent{
+		{Type: NoiseLevelTooHigh, Timestamp: time.Now()},
+	})
+
+	if estimate != (FusedEstimate{}) {
+		t.Fatalf("expected the zero estimate, got %+v", estimate)
+	}
+}
@@ -0,0 +1,139 @@
+//go:build hardware
+
+package as3935go
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"testing"
+)
+
+// Open the real module for the hardware-in-the-loop tests, configured via the environment variables:
+//
+//	AS3935_DEVICE  - the i2c device path, for example /dev/i2c-1
+//	AS3935_ADDRESS - the i2c address of the module, for example 0x03
+//
+// The tests are built only with the hardware build tag and are skipped when the variables are not set.
+//
+//	go test -tags hardware ./...
+func openHardwareModule(t *testing.T) Module {
+	t.Helper()
+
+	device, address := os.Getenv("AS3935_DEVICE"), os.Getenv("AS3935_ADDRESS")
+	if len(device) == 0 || len(address) == 0 {
+		t.Skip("AS3935_DEVICE and AS3935_ADDRESS are not set")
+	}
+
+	parsedAddress, err := strconv.ParseInt(address, 0, 0)
+	if err != nil {
+		t.Fatalf("invalid AS3935_ADDRESS value: %s", err)
+	}
+
+	m, err := NewModule(device, int(parsedAddress))
+	if err != nil {
+		t.Fatalf("failed to create the module: %s", err)
+	}
+
+	if err := m.Open(); err != nil {
+		t.Fatalf("failed to open the module: %s", err)
+	}
+
+	t.Cleanup(func() {
+		if err := m.Close(); err != nil && !errors.Is(err, ErrNotConnected) {
+			t.Errorf("failed to close the module: %s", err)
+		}
+	})
+
+	return m
+}
+
+func TestHardwareOpenClose(t *testing.T) {
+	m := openHardwareModule(t)
+
+	if err := m.Close(); err != nil {
+		t.Fatalf("failed to close the module: %s", err)
+	}
+
+	if err := m.Open(); err != nil {
+		t.Fatalf("failed to open the module again: %s", err)
+	}
+}
+
+func TestHardwareInitializeDefaults(t *testing.T) {
+	m := openHardwareModule(t)
+
+	if err := m.InitializeDefaults(); err != nil {
+		t.Fatalf("failed to initialize the defaults: %s", err)
+	}
+
+	ok, mismatches, err := m.VerifyDefaults()
+	if err != nil {
+		t.Fatalf("failed to verify the defaults: %s", err)
+	}
+
+	if !ok {
+		t.Fatalf("the registers % 02x are not the defaults after the preset default", mismatches)
+	}
+}
+
+func TestHardwarePowerSwitch(t *testing.T) {
+	m := openHardwareModule(t)
+
+	if err := m.PowerSwitch(false); err != nil {
+		t.Fatalf("failed to power down the module: %s", err)
+	}
+
+	if err := m.PowerSwitch(true); err != nil {
+		t.Fatalf("failed to power up the module: %s", err)
+	}
+
+	powered, err := m.IsPoweredUp()
+	if err != nil {
+		t.Fatalf("failed to read the power state: %s", err)
+	}
+
+	if !powered {
+		t.Fatal("the module is not powered up after the power up")
+	}
+}
+
+func TestHardwareRegisterRoundTrip(t *testing.T) {
+	m := openHardwareModule(t)
+
+	original, err := m.ReadRegister(RegisterNoiseFloor)
+	if err != nil {
+		t.Fatalf("failed to read the register: %s", err)
+	}
+
+	t.Cleanup(func() {
+		if err := m.WriteRegister(RegisterNoiseFloor, original); err != nil {
+			t.Errorf("failed to restore the register: %s", err)
+		}
+	})
+
+	value := original ^ 0x01
+	if err := m.WriteRegister(RegisterNoiseFloor, value); err != nil {
+		t.Fatalf("failed to write the register: %s", err)
+	}
+
+	register, err := m.ReadRegister(RegisterNoiseFloor)
+	if err != nil {
+		t.Fatalf("failed to read the register back: %s", err)
+	}
+
+	if register != value {
+		t.Fatalf("read 0x%02x after writing 0x%02x", register, value)
+	}
+}
+
+func TestHardwareDumpRegisters(t *testing.T) {
+	m := openHardwareModule(t)
+
+	registers, err := m.DumpRegisters()
+	if err != nil {
+		t.Fatalf("failed to dump the registers: %s", err)
+	}
+
+	t.Log("\n" + FormatDump(registers))
+}
@@ -0,0 +1,163 @@
+package internal
+
+import (
+	"fmt"
+	"io"
+	"sync"
+)
+
+// Create a new I2C device decorator logging the state of the registers into the debugOut writer on
+// every register read and write of the inner device. The writer is flushed after every operation if it
+// implements the Flush() error method, like the bufio.Writer.
+func NewDebugI2c(inner I2c, debugOut io.Writer) I2c {
+	return &debugI2c{
+		Inner:    inner,
+		DebugOut: debugOut,
+	}
+}
+
+// The mutex keeps the output of the concurrent register reads from interleaving.
+type debugI2c struct {
+	Inner    I2c
+	DebugOut io.Writer
+	mu       sync.Mutex
+}
+
+func (d *debugI2c) Open() error {
+	return d.Inner.Open()
+}
+
+func (d *debugI2c) Close() error {
+	return d.Inner.Close()
+}
+
+func (d *debugI2c) RegRead(offset uint8) (uint8, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	defer d.flush()
+
+	value, err := d.Inner.RegRead(offset)
+	if err != nil {
+		return 0x00, err
+	}
+
+	fmt.Fprintf(d.DebugOut, "[ Read ] Offset: 0x%02x:\n", offset)
+	if offset >= ReadBufferSize {
+		fmt.Fprintf(d.DebugOut, "[%08b]\n", value)
+		return value, nil
+	}
+
+	registers, err := d.readRegisters()
+	if err != nil {
+		return 0x00, err
+	}
+
+	d.printRegisters(registers, offset)
+	return value, nil
+}
+
+func (d *debugI2c) RegWrite(offset, value uint8) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	defer d.flush()
+
+	if offset >= ReadBufferSize {
+		if err := d.Inner.RegWrite(offset, value); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(d.DebugOut, "[ Write ] Value: 0x%02x Offset: 0x%02x\n", value, offset)
+		return nil
+	}
+
+	before, err := d.readRegisters()
+	if err != nil {
+		return err
+	}
+
+	if err := d.Inner.RegWrite(offset, value); err != nil {
+		return err
+	}
+
+	after, err := d.readRegisters()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(d.DebugOut, "[ Write ] Value: 0x%02x Offset: 0x%02x:\n", value, offset)
+	d.printRegisters(before, offset)
+	d.printRegisters(after, offset)
+	return nil
+}
+
+func (d *debugI2c) RegWriteMasked(offset, value, mask uint8) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	defer d.flush()
+
+	if offset >= ReadBufferSize {
+		if err := d.Inner.RegWriteMasked(offset, value, mask); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(d.DebugOut, "[ Write Masked ] Value: 0x%02x Mask: 0x%02x Offset: 0x%02x\n", value, mask, offset)
+		return nil
+	}
+
+	before, err := d.readRegisters()
+	if err != nil {
+		return err
+	}
+
+	if err := d.Inner.RegWriteMasked(offset, value, mask); err != nil {
+		return err
+	}
+
+	after, err := d.readRegisters()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(d.DebugOut, "[ Write Masked ] Value: 0x%02x Mask: 0x%02x Offset: 0x%02x:\n", value, mask, offset)
+	d.printRegisters(before, offset)
+	d.printRegisters(after, offset)
+	return nil
+}
+
+// Flush the debug output if it is buffered, so the logged register state survives a crash. The flush
+// errors are ignored, same as the logging errors.
+func (d *debugI2c) flush() {
+	if flusher, ok := d.DebugOut.(interface{ Flush() error }); ok {
+		_ = flusher.Flush()
+	}
+}
+
+// Read the registers of the workaround block to compare them before and after the operation.
+func (d *debugI2c) readRegisters() ([ReadBufferSize]uint8, error) {
+	registers := [ReadBufferSize]uint8{}
+	for offset := range registers {
+		value, err := d.Inner.RegRead(uint8(offset))
+		if err != nil {
+			return registers, fmt.Errorf("as3935: failed to read the value at the given offset via i2c for logging purposes: %w", err)
+		}
+
+		registers[offset] = value
+	}
+
+	return registers, nil
+}
+
+// Print the registers bitmap with the register at the given offset highlighted.
+func (d *debugI2c) printRegisters(registers [ReadBufferSize]uint8, offset uint8) {
+	for regOffset, regValue := range registers {
+		if uint8(regOffset) == offset {
+			fmt.Fprintf(d.DebugOut, "[%08b]", regValue)
+		} else {
+			fmt.Fprintf(d.DebugOut, " %08b ", regValue)
+		}
+
+		fmt.Fprintf(d.DebugOut, " ")
+	}
+
+	fmt.Fprintf(d.DebugOut, "\n")
+}
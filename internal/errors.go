@@ -0,0 +1,26 @@
+package internal
+
+import "errors"
+
+var (
+	// The operation requires an opened connection to the module.
+	ErrNotConnected = errors.New("not connected")
+
+	// The connection to the module is already opened.
+	ErrAlreadyConnected = errors.New("already connected")
+
+	// The provided value is out of the range supported by the module.
+	ErrValueOutOfRange = errors.New("value out of range")
+
+	// The value read from the register does not correspond to any valid value.
+	ErrCorruptedRegister = errors.New("corrupted register value")
+
+	// The communication with the module over the bus has failed.
+	ErrBusFailure = errors.New("bus failure")
+
+	// The communication with the module over the bus has not completed within the operation timeout.
+	ErrBusTimeout = errors.New("bus timeout")
+
+	// The value read back from the register does not match the written value.
+	ErrWriteVerifyFailed = errors.New("write verification failed")
+)
@@ -0,0 +1,43 @@
+package internal
+
+// Create a new I2C device decorator calling the hook with every error returned by the inner device.
+func NewErrorHookI2c(inner I2c, hook func(error)) I2c {
+	return &errorHookI2c{
+		Inner: inner,
+		Hook:  hook,
+	}
+}
+
+type errorHookI2c struct {
+	Inner I2c
+	Hook  func(error)
+}
+
+func (h *errorHookI2c) Open() error {
+	return h.observe(h.Inner.Open())
+}
+
+func (h *errorHookI2c) Close() error {
+	return h.observe(h.Inner.Close())
+}
+
+func (h *errorHookI2c) RegRead(offset uint8) (uint8, error) {
+	value, err := h.Inner.RegRead(offset)
+	return value, h.observe(err)
+}
+
+func (h *errorHookI2c) RegWrite(offset, value uint8) error {
+	return h.observe(h.Inner.RegWrite(offset, value))
+}
+
+func (h *errorHookI2c) RegWriteMasked(offset, value, mask uint8) error {
+	return h.observe(h.Inner.RegWriteMasked(offset, value, mask))
+}
+
+func (h *errorHookI2c) observe(err error) error {
+	if err != nil {
+		h.Hook(err)
+	}
+
+	return err
+}
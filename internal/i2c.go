@@ -2,7 +2,7 @@ package internal
 
 import (
 	"fmt"
-	"io"
+	"sync"
 
 	"golang.org/x/exp/io/i2c"
 )
@@ -25,42 +25,46 @@ type I2c interface {
 }
 
 const (
-	ReadBufferSize  uint8 = 9
-	WriteBufferSize uint8 = 1
+	ReadBufferSize    uint8 = 9
+	WriteBufferSize   uint8 = 1
+	MaxRegisterOffset uint8 = 0x3F
 )
 
-// Create a new I2C device wrapper instance
-func NewI2cDevice(device string, address int, debugOut io.Writer) (I2c, error) {
+// Create a new I2C device wrapper instance. The bulkRead parameter enables the workaround reading the
+// whole 0x00-0x08 block for every register from the block.
+func NewI2cDevice(device string, address int, bulkRead bool) (I2c, error) {
 	if len(device) == 0 {
 		return nil, fmt.Errorf("as3935: invalid i2c device specified")
 	}
 
 	if address < 0 {
-		return nil, fmt.Errorf("as3935: invalid i2c address specified")
+		return nil, fmt.Errorf("as3935: invalid i2c address specified: %w", ErrValueOutOfRange)
 	}
 
 	return &i2cWrapper{
-		DeviceFs:    device,
-		Device:      nil,
-		Address:     address,
-		BufferRead:  make([]uint8, ReadBufferSize),
-		BufferWrite: make([]uint8, WriteBufferSize),
-		DebugOut:    debugOut,
+		DeviceFs: device,
+		Device:   nil,
+		Address:  address,
+		BulkRead: bulkRead,
 	}, nil
 }
 
+// The mutex guards the device, because the module allows concurrent register reads. The read and write
+// buffers are local to every operation, so there is no shared mutable state besides the device.
 type i2cWrapper struct {
-	DeviceFs    string
-	Device      *i2c.Device
-	Address     int
-	BufferRead  []uint8
-	BufferWrite []uint8
-	DebugOut    io.Writer
+	DeviceFs string
+	Device   *i2c.Device
+	Address  int
+	BulkRead bool
+	mu       sync.Mutex
 }
 
 func (i *i2cWrapper) Close() error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
 	if i.Device == nil {
-		return fmt.Errorf("as3935: the module is not connected")
+		return fmt.Errorf("as3935: the module is not connected: %w", ErrNotConnected)
 	}
 
 	defer func() {
@@ -68,15 +72,18 @@ func (i *i2cWrapper) Close() error {
 	}()
 
 	if err := i.Device.Close(); err != nil {
-		return fmt.Errorf("as3935: underlying i2c connection closing failure: %w", err)
+		return fmt.Errorf("as3935: underlying i2c connection closing failure: %w: %w", ErrBusFailure, err)
 	}
 
 	return nil
 }
 
 func (i *i2cWrapper) Open() error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
 	if i.Device != nil {
-		return fmt.Errorf("as3935: the module is already connected")
+		return fmt.Errorf("as3935: the module is already connected: %w", ErrAlreadyConnected)
 	}
 
 	devFs := &i2c.Devfs{
@@ -85,103 +92,63 @@ func (i *i2cWrapper) Open() error {
 
 	dev, err := i2c.Open(devFs, i.Address)
 	if err != nil {
-		return fmt.Errorf("as3935: failed to open the connection to the module: %w", err)
+		return fmt.Errorf("as3935: failed to open the connection to the module: %w: %w", ErrBusFailure, err)
 	}
 
 	i.Device = dev
 	return nil
 }
 
+// The AS3935 IC does not return the correct value when a single register from the 0x00-0x08 block is read
+// via i2c. As a workaround the whole block is read starting from the 0x00 offset and the requested
+// register is taken from the buffer. The registers above the block, like the calibration results at
+// 0x3A/0x3B, and all registers when the workaround is disabled, are read directly with a single byte read.
 func (i *i2cWrapper) RegRead(offset uint8) (uint8, error) {
-	// TODO: The function is performing a workaround for the broken I2C reading in the AS3935 IC
+	i.mu.Lock()
+	defer i.mu.Unlock()
 
-	if offset >= ReadBufferSize {
-		return 0x00, fmt.Errorf("as3935: the offset is out of the module register range")
+	if i.Device == nil {
+		return 0x00, fmt.Errorf("as3935: the module is not connected: %w", ErrNotConnected)
 	}
 
-	if err := i.Device.ReadReg(0x00, i.BufferRead); err != nil {
-		return 0x00, fmt.Errorf("as3935: failed to read the value at the given offset via i2c: %w", err)
+	if offset > MaxRegisterOffset {
+		return 0x00, fmt.Errorf("as3935: the offset is out of the module register range: %w", ErrValueOutOfRange)
 	}
 
-	// NOTE: Debug logging logic
-	if i.DebugOut != nil {
-		fmt.Fprintf(i.DebugOut, "[ Read ] Offset: 0x%02x:\n", offset)
-		for regOffset, regValue := range i.BufferRead {
-			if uint8(regOffset) == offset {
-				fmt.Fprintf(i.DebugOut, "[%08b]", regValue)
-			} else {
-				fmt.Fprintf(i.DebugOut, " %08b ", regValue)
-			}
-
-			fmt.Fprintf(i.DebugOut, " ")
+	if offset >= ReadBufferSize || !i.BulkRead {
+		buffer := [1]uint8{}
+		if err := i.Device.ReadReg(offset, buffer[:]); err != nil {
+			return 0x00, fmt.Errorf("as3935: failed to read the value at the given offset via i2c: %w: %w", ErrBusFailure, err)
 		}
-		fmt.Fprintf(i.DebugOut, "\n")
+
+		return buffer[0], nil
+	}
+
+	buffer := [ReadBufferSize]uint8{}
+	if err := i.Device.ReadReg(0x00, buffer[:]); err != nil {
+		return 0x00, fmt.Errorf("as3935: failed to read the value at the given offset via i2c: %w: %w", ErrBusFailure, err)
 	}
 
-	return i.BufferRead[offset], nil
+	return buffer[offset], nil
 }
 
 func (i *i2cWrapper) RegWrite(offset, value uint8) error {
-	i.BufferWrite[0] = value
+	i.mu.Lock()
+	defer i.mu.Unlock()
 
-	// NOTE: Debug logging logic. Load registers into buffer to compare them
-	if i.DebugOut != nil && offset < ReadBufferSize {
-		if _, err := i.RegRead(offset); err != nil {
-			return fmt.Errorf("as3935: failed to read the value at the given offset via i2c for logging purposes: %w", err)
-		}
-	}
-
-	err := i.Device.WriteReg(offset, i.BufferWrite)
-	if err != nil {
-		return fmt.Errorf("as3935: failed to write the value at the given offset via i2c: %w", err)
+	if i.Device == nil {
+		return fmt.Errorf("as3935: the module is not connected: %w", ErrNotConnected)
 	}
 
-	if i.DebugOut != nil {
-		if offset < ReadBufferSize {
-			fmt.Fprintf(i.DebugOut, "[ Write ] Value: 0x%02x Offset: 0x%02x:\n", value, offset)
-			for regOffset, regValue := range i.BufferRead {
-				if uint8(regOffset) == offset {
-					fmt.Fprintf(i.DebugOut, "[%08b]", regValue)
-				} else {
-					fmt.Fprintf(i.DebugOut, " %08b ", regValue)
-				}
-
-				fmt.Fprintf(i.DebugOut, " ")
-			}
-
-			fmt.Fprintf(i.DebugOut, "\n")
-
-			debugOut := i.DebugOut
-			i.DebugOut = nil
-			if _, err := i.RegRead(offset); err != nil {
-				return fmt.Errorf("as3935: failed to read the value at the given offset via i2c for logging purposes: %w", err)
-			}
-			i.DebugOut = debugOut
-
-			for regOffset, regValue := range i.BufferRead {
-				if uint8(regOffset) == offset {
-					fmt.Fprintf(i.DebugOut, "[%08b]", regValue)
-				} else {
-					fmt.Fprintf(i.DebugOut, " %08b ", regValue)
-				}
-
-				fmt.Fprintf(i.DebugOut, " ")
-			}
-
-			fmt.Fprintf(i.DebugOut, "\n")
-		} else {
-			fmt.Fprintf(i.DebugOut, "[ Write ] Value: 0x%02x Offset: 0x%02x\n", value, offset)
-		}
-
+	buffer := [WriteBufferSize]uint8{value}
+	if err := i.Device.WriteReg(offset, buffer[:]); err != nil {
+		return fmt.Errorf("as3935: failed to write the value at the given offset via i2c: %w: %w", ErrBusFailure, err)
 	}
 
 	return nil
 }
 
 func (i *i2cWrapper) RegWriteMasked(offset, value, mask uint8) error {
-	debugOut := i.DebugOut
-	i.DebugOut = nil
-
 	register, err := i.RegRead(offset)
 	if err != nil {
 		return fmt.Errorf("as3935: failed to read the register for masked writing: %w", err)
@@ -193,40 +160,5 @@ func (i *i2cWrapper) RegWriteMasked(offset, value, mask uint8) error {
 		return fmt.Errorf("as3935: failed to write the register for masked writing: %w", err)
 	}
 
-	i.DebugOut = debugOut
-	if i.DebugOut != nil {
-		fmt.Fprintf(i.DebugOut, "[ Write Masked ] Value: 0x%02x Mask: 0x%02x Offset: 0x%02x:\n", value, mask, offset)
-		for regOffset, regValue := range i.BufferRead {
-			if uint8(regOffset) == offset {
-				fmt.Fprintf(i.DebugOut, "[%08b]", regValue)
-			} else {
-				fmt.Fprintf(i.DebugOut, " %08b ", regValue)
-			}
-
-			fmt.Fprintf(i.DebugOut, " ")
-		}
-
-		fmt.Fprintf(i.DebugOut, "\n")
-
-		debugOut = i.DebugOut
-		i.DebugOut = nil
-		if _, err := i.RegRead(offset); err != nil {
-			return fmt.Errorf("as3935: failed to read the value at the given offset via i2c for logging purposes: %w", err)
-		}
-		i.DebugOut = debugOut
-
-		for regOffset, regValue := range i.BufferRead {
-			if uint8(regOffset) == offset {
-				fmt.Fprintf(i.DebugOut, "[%08b]", regValue)
-			} else {
-				fmt.Fprintf(i.DebugOut, " %08b ", regValue)
-			}
-
-			fmt.Fprintf(i.DebugOut, " ")
-		}
-
-		fmt.Fprintf(i.DebugOut, "\n")
-	}
-
 	return nil
 }
@@ -0,0 +1,59 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Create a new I2C device decorator logging the register operations of the inner device as structured
+// "register.read" and "register.write" events. The successful operations are logged at the debug level and
+// the failed operations at the error level.
+func NewLoggerI2c(inner I2c, logger *slog.Logger) I2c {
+	return &loggerI2c{
+		Inner:  inner,
+		Logger: logger,
+	}
+}
+
+type loggerI2c struct {
+	Inner  I2c
+	Logger *slog.Logger
+}
+
+func (l *loggerI2c) Open() error {
+	return l.Inner.Open()
+}
+
+func (l *loggerI2c) Close() error {
+	return l.Inner.Close()
+}
+
+func (l *loggerI2c) RegRead(offset uint8) (uint8, error) {
+	value, err := l.Inner.RegRead(offset)
+	l.log("register.read", err, slog.Int("offset", int(offset)), slog.Int("value", int(value)))
+
+	return value, err
+}
+
+func (l *loggerI2c) RegWrite(offset, value uint8) error {
+	err := l.Inner.RegWrite(offset, value)
+	l.log("register.write", err, slog.Int("offset", int(offset)), slog.Int("value", int(value)))
+
+	return err
+}
+
+func (l *loggerI2c) RegWriteMasked(offset, value, mask uint8) error {
+	err := l.Inner.RegWriteMasked(offset, value, mask)
+	l.log("register.write", err, slog.Int("offset", int(offset)), slog.Int("value", int(value)), slog.Int("mask", int(mask)))
+
+	return err
+}
+
+func (l *loggerI2c) log(msg string, err error, attrs ...slog.Attr) {
+	if err != nil {
+		l.Logger.LogAttrs(context.Background(), slog.LevelError, msg, append(attrs, slog.Any("error", err))...)
+		return
+	}
+
+	l.Logger.LogAttrs(context.Background(), slog.LevelDebug, msg, attrs...)
+}
@@ -0,0 +1,125 @@
+package internal
+
+import (
+	"fmt"
+	"sync"
+)
+
+// The values of the registers after the power-up or after the PRESET_DEFAULT direct command.
+var DefaultRegisters = [9]uint8{0x24, 0x22, 0xC2, 0x00, 0x00, 0x00, 0x00, 0x3F, 0x00}
+
+// Create a new in-memory I2C device with the registers set to the power-up defaults. The PRESET_DEFAULT
+// and CALIB_RCO direct commands are emulated.
+func NewMemoryDevice() *MemoryDevice {
+	device := &MemoryDevice{
+		Registers: [MaxRegisterOffset + 1]uint8{},
+		Connected: false,
+		mu:        sync.Mutex{},
+	}
+
+	device.presetDefault()
+	return device
+}
+
+// In-memory implementation of the I2c interface used for testing without the hardware.
+type MemoryDevice struct {
+	Registers [MaxRegisterOffset + 1]uint8
+	Connected bool
+	mu        sync.Mutex
+}
+
+// Set the value of the register specified by the offset parameter bypassing the connection state.
+func (d *MemoryDevice) SetRegister(offset, value uint8) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.Registers[offset&MaxRegisterOffset] = value
+}
+
+// Get the value of the register specified by the offset parameter bypassing the connection state.
+func (d *MemoryDevice) GetRegister(offset uint8) uint8 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.Registers[offset&MaxRegisterOffset]
+}
+
+func (d *MemoryDevice) Open() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.Connected {
+		return fmt.Errorf("as3935: the module is already connected: %w", ErrAlreadyConnected)
+	}
+
+	d.Connected = true
+	return nil
+}
+
+func (d *MemoryDevice) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.Connected {
+		return fmt.Errorf("as3935: the module is not connected: %w", ErrNotConnected)
+	}
+
+	d.Connected = false
+	return nil
+}
+
+func (d *MemoryDevice) RegRead(offset uint8) (uint8, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.Connected {
+		return 0x00, fmt.Errorf("as3935: the module is not connected: %w", ErrNotConnected)
+	}
+
+	if offset > MaxRegisterOffset {
+		return 0x00, fmt.Errorf("as3935: the offset is out of the module register range: %w", ErrValueOutOfRange)
+	}
+
+	return d.Registers[offset], nil
+}
+
+func (d *MemoryDevice) RegWrite(offset, value uint8) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.Connected {
+		return fmt.Errorf("as3935: the module is not connected: %w", ErrNotConnected)
+	}
+
+	if offset > MaxRegisterOffset {
+		return fmt.Errorf("as3935: the offset is out of the module register range: %w", ErrValueOutOfRange)
+	}
+
+	d.Registers[offset] = value
+
+	// NOTE: Emulation of the PRESET_DEFAULT and CALIB_RCO direct commands
+	switch {
+	case offset == 0x3C && value == 0x96:
+		d.presetDefault()
+	case offset == 0x3D && value == 0x96:
+		d.Registers[0x3A] = 0x80
+		d.Registers[0x3B] = 0x80
+	}
+
+	return nil
+}
+
+func (d *MemoryDevice) RegWriteMasked(offset, value, mask uint8) error {
+	d.mu.Lock()
+	register := d.Registers[offset&MaxRegisterOffset]
+	d.mu.Unlock()
+
+	return d.RegWrite(offset, (register & ^mask)|(value&mask))
+}
+
+// The preset also resets the calibration results, which are set again by the CALIB_RCO direct command.
+func (d *MemoryDevice) presetDefault() {
+	copy(d.Registers[:], DefaultRegisters[:])
+	d.Registers[0x3A] = 0x00
+	d.Registers[0x3B] = 0x00
+}
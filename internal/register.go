@@ -0,0 +1,73 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The error of a register operation carrying the register offset and the operation ("read" or "write").
+type RegisterError struct {
+	Offset uint8
+	Op     string
+	Err    error
+}
+
+func (e *RegisterError) Error() string {
+	return fmt.Sprintf("as3935: register 0x%02x %s failed: %s", e.Offset, e.Op, e.Err)
+}
+
+func (e *RegisterError) Unwrap() error {
+	return e.Err
+}
+
+// Create a new I2C device decorator wrapping the errors of the inner device register operations with the
+// RegisterError, unless the error already contains one.
+func NewRegisterErrorI2c(inner I2c) I2c {
+	return &registerErrorI2c{
+		Inner: inner,
+	}
+}
+
+type registerErrorI2c struct {
+	Inner I2c
+}
+
+func (r *registerErrorI2c) Open() error {
+	return r.Inner.Open()
+}
+
+func (r *registerErrorI2c) Close() error {
+	return r.Inner.Close()
+}
+
+func (r *registerErrorI2c) RegRead(offset uint8) (uint8, error) {
+	value, err := r.Inner.RegRead(offset)
+	return value, wrapRegisterError(offset, "read", err)
+}
+
+func (r *registerErrorI2c) RegWrite(offset, value uint8) error {
+	return wrapRegisterError(offset, "write", r.Inner.RegWrite(offset, value))
+}
+
+func (r *registerErrorI2c) RegWriteMasked(offset, value, mask uint8) error {
+	return wrapRegisterError(offset, "write", r.Inner.RegWriteMasked(offset, value, mask))
+}
+
+// The nil error is returned before the errors.As target is declared, which would otherwise escape to the
+// heap and allocate on every successful operation.
+func wrapRegisterError(offset uint8, op string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var registerErr *RegisterError
+	if errors.As(err, &registerErr) {
+		return err
+	}
+
+	return &RegisterError{
+		Offset: offset,
+		Op:     op,
+		Err:    err,
+	}
+}
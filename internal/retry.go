@@ -0,0 +1,81 @@
+package internal
+
+import (
+	"errors"
+	"time"
+)
+
+// Create a new I2C device decorator retrying the register operations of the inner device which failed
+// with the ErrBusFailure error. The operation is performed at most attempts times with the backoff delay
+// between the attempts. The Open and Close operations are not retried.
+func NewRetryI2c(inner I2c, attempts int, backoff time.Duration) I2c {
+	return NewRetryI2cWithSleep(inner, attempts, backoff, time.Sleep)
+}
+
+// Create a new I2C device decorator retrying the register operations like NewRetryI2c, which awaits the
+// backoff delay with the provided sleep function.
+func NewRetryI2cWithSleep(inner I2c, attempts int, backoff time.Duration, sleep func(time.Duration)) I2c {
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	return &retryI2c{
+		Inner:    inner,
+		Attempts: attempts,
+		Backoff:  backoff,
+		Sleep:    sleep,
+	}
+}
+
+type retryI2c struct {
+	Inner    I2c
+	Attempts int
+	Backoff  time.Duration
+	Sleep    func(time.Duration)
+}
+
+func (r *retryI2c) Open() error {
+	return r.Inner.Open()
+}
+
+func (r *retryI2c) Close() error {
+	return r.Inner.Close()
+}
+
+func (r *retryI2c) RegRead(offset uint8) (uint8, error) {
+	var value uint8
+	err := r.retry(func() error {
+		var err error
+		value, err = r.Inner.RegRead(offset)
+		return err
+	})
+
+	return value, err
+}
+
+func (r *retryI2c) RegWrite(offset, value uint8) error {
+	return r.retry(func() error {
+		return r.Inner.RegWrite(offset, value)
+	})
+}
+
+func (r *retryI2c) RegWriteMasked(offset, value, mask uint8) error {
+	return r.retry(func() error {
+		return r.Inner.RegWriteMasked(offset, value, mask)
+	})
+}
+
+func (r *retryI2c) retry(operation func() error) error {
+	var err error
+	for attempt := 1; attempt <= r.Attempts; attempt += 1 {
+		if err = operation(); err == nil || !errors.Is(err, ErrBusFailure) {
+			return err
+		}
+
+		if attempt < r.Attempts {
+			r.Sleep(r.Backoff)
+		}
+	}
+
+	return err
+}
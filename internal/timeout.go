@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"fmt"
+	"time"
+)
+
+// Create a new I2C device decorator failing the register operations of the inner device which are not
+// completed within the timeout with the ErrBusTimeout error. The timed out operation keeps running in the
+// background and the following operations are waiting for it to complete, so the inner device is never
+// accessed concurrently. The Open and Close operations are not limited.
+func NewTimeoutI2c(inner I2c, timeout time.Duration) I2c {
+	return &timeoutI2c{
+		Inner:   inner,
+		Timeout: timeout,
+		Busy:    make(chan struct{}, 1),
+	}
+}
+
+type timeoutI2c struct {
+	Inner   I2c
+	Timeout time.Duration
+	Busy    chan struct{}
+}
+
+func (t *timeoutI2c) Open() error {
+	return t.Inner.Open()
+}
+
+func (t *timeoutI2c) Close() error {
+	return t.Inner.Close()
+}
+
+func (t *timeoutI2c) RegRead(offset uint8) (uint8, error) {
+	var value uint8
+	err := t.limit(func() error {
+		var err error
+		value, err = t.Inner.RegRead(offset)
+		return err
+	})
+
+	return value, err
+}
+
+func (t *timeoutI2c) RegWrite(offset, value uint8) error {
+	return t.limit(func() error {
+		return t.Inner.RegWrite(offset, value)
+	})
+}
+
+func (t *timeoutI2c) RegWriteMasked(offset, value, mask uint8) error {
+	return t.limit(func() error {
+		return t.Inner.RegWriteMasked(offset, value, mask)
+	})
+}
+
+func (t *timeoutI2c) limit(operation func() error) error {
+	timer := time.NewTimer(t.Timeout)
+	defer timer.Stop()
+
+	select {
+	case t.Busy <- struct{}{}:
+	case <-timer.C:
+		return fmt.Errorf("as3935: the previous bus operation is still in progress: %w", ErrBusTimeout)
+	}
+
+	result := make(chan error, 1)
+	go func() {
+		defer func() { <-t.Busy }()
+		result <- operation()
+	}()
+
+	select {
+	case err := <-result:
+		return err
+	case <-timer.C:
+		return fmt.Errorf("as3935: the bus operation has not completed within %s: %w", t.Timeout, ErrBusTimeout)
+	}
+}
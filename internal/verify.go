@@ -0,0 +1,49 @@
+package internal
+
+import "fmt"
+
+// Create a new I2C device decorator reading back the register after every masked write of the inner device
+// and failing with the ErrWriteVerifyFailed error when the masked bits do not match the written value.
+func NewVerifyI2c(inner I2c) I2c {
+	return &verifyI2c{
+		Inner: inner,
+	}
+}
+
+type verifyI2c struct {
+	Inner I2c
+}
+
+func (v *verifyI2c) Open() error {
+	return v.Inner.Open()
+}
+
+func (v *verifyI2c) Close() error {
+	return v.Inner.Close()
+}
+
+func (v *verifyI2c) RegRead(offset uint8) (uint8, error) {
+	return v.Inner.RegRead(offset)
+}
+
+func (v *verifyI2c) RegWrite(offset, value uint8) error {
+	return v.Inner.RegWrite(offset, value)
+}
+
+func (v *verifyI2c) RegWriteMasked(offset, value, mask uint8) error {
+	if err := v.Inner.RegWriteMasked(offset, value, mask); err != nil {
+		return err
+	}
+
+	register, err := v.Inner.RegRead(offset)
+	if err != nil {
+		return fmt.Errorf("as3935: failed to read the register for the write verification: %w", err)
+	}
+
+	if register&mask != value&mask {
+		return fmt.Errorf("as3935: the register 0x%02x value 0x%02x does not match the written value 0x%02x with mask 0x%02x: %w",
+			offset, register, value, mask, ErrWriteVerifyFailed)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package as3935go
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Log the interrupt read from the interrupt register as the "interrupt" event if the logger is configured.
+func (m *module) logInterrupt(interrupt InterruptType) {
+	if m.options.logger == nil {
+		return
+	}
+
+	m.options.logger.LogAttrs(context.Background(), slog.LevelInfo, "interrupt",
+		slog.String("type", interrupt.String()),
+		slog.Int("address", m.address))
+}
+
+// Log the result of the oscillators calibration as the "calibration" event if the logger is configured.
+func (m *module) logCalibration(sequence string, status CalibrationStatus) {
+	if m.options.logger == nil {
+		return
+	}
+
+	level := slog.LevelInfo
+	if status.TRCONok || status.SRCONok {
+		level = slog.LevelWarn
+	}
+
+	m.options.logger.LogAttrs(context.Background(), level, "calibration",
+		slog.String("sequence", sequence),
+		slog.Bool("trco_done", status.TRCODone),
+		slog.Bool("trco_nok", status.TRCONok),
+		slog.Bool("srco_done", status.SRCODone),
+		slog.Bool("srco_nok", status.SRCONok),
+		slog.Int("address", m.address))
+}
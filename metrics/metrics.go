@@ -0,0 +1,104 @@
+// Package metrics exports the AS3935 module metrics to Prometheus. The Collector is attached to the module
+// with the as3935go.WithObserver option and registered in the Prometheus registry, so the metrics are
+// derived from the module calls already happening instead of polling the module independently. The package
+// is a separate module, so the library itself does not depend on the Prometheus client.
+package metrics
+
+import (
+	"math"
+
+	as3935go "github.com/Krzysztofz01/as3935-go"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Create a new collector of the module metrics. The metric names are prefixed with the namespace.
+func NewCollector(namespace string) *Collector {
+	return &Collector{
+		interrupts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "interrupts_total",
+			Help:      "The total number of the interrupts read from the module by the interrupt type.",
+		}, []string{"type"}),
+		lastDistance: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "last_distance_km",
+			Help:      "The estimated distance of the latest lightning in km, +Inf when out of range.",
+		}),
+		lastEnergy: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "last_energy",
+			Help:      "The energy of the latest lightning strike.",
+		}),
+		noiseFloorLevel: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "noise_floor_level",
+			Help:      "The latest noise floor level (0-7) read from or written to the module.",
+		}),
+		busErrors: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "bus_errors_total",
+			Help:      "The total number of the bus failures of the module transport.",
+		}),
+	}
+}
+
+// The Prometheus collector of the module metrics implementing the as3935go.Observer interface.
+type Collector struct {
+	interrupts      *prometheus.CounterVec
+	lastDistance    prometheus.Gauge
+	lastEnergy      prometheus.Gauge
+	noiseFloorLevel prometheus.Gauge
+	busErrors       prometheus.Counter
+}
+
+var (
+	_ prometheus.Collector = (*Collector)(nil)
+	_ as3935go.Observer    = (*Collector)(nil)
+)
+
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.interrupts.Describe(ch)
+	c.lastDistance.Describe(ch)
+	c.lastEnergy.Describe(ch)
+	c.noiseFloorLevel.Describe(ch)
+	c.busErrors.Describe(ch)
+}
+
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.interrupts.Collect(ch)
+	c.lastDistance.Collect(ch)
+	c.lastEnergy.Collect(ch)
+	c.noiseFloorLevel.Collect(ch)
+	c.busErrors.Collect(ch)
+}
+
+// The NoResults reads are not counted as interrupts.
+func (c *Collector) ObserveInterrupt(interrupt as3935go.InterruptType) {
+	if interrupt == as3935go.NoResults {
+		return
+	}
+
+	c.interrupts.WithLabelValues(interrupt.String()).Inc()
+}
+
+func (c *Collector) ObserveStrike(event as3935go.StrikeEvent) {
+	if event.Type != as3935go.LightningInterrupt {
+		return
+	}
+
+	if event.DistanceKm == math.MaxInt {
+		c.lastDistance.Set(math.Inf(1))
+	} else {
+		c.lastDistance.Set(float64(event.DistanceKm))
+	}
+
+	c.lastEnergy.Set(event.Energy)
+}
+
+func (c *Collector) ObserveNoiseFloorLevel(level as3935go.NoiseFloorLevel) {
+	c.noiseFloorLevel.Set(float64(level >> 4))
+}
+
+func (c *Collector) ObserveBusError(err error) {
+	c.busErrors.Inc()
+}
@@ -0,0 +1,74 @@
+package as3935go
+
+import (
+	"github.com/Krzysztofz01/as3935-go/internal"
+)
+
+// The AS3935 module backed by an in-memory register array instead of a real device. The registers can be
+// preloaded to simulate the behavior of the module, for example a lightning interrupt.
+type MockModule interface {
+	Module
+
+	// Set the value of the register at the given offset. The value is set regardless of the connection state.
+	SetRegister(offset, value uint8)
+
+	// Get the value of the register at the given offset. The value is read regardless of the connection state.
+	GetRegister(offset uint8) uint8
+
+	// Set the interrupt, distance and energy registers to the values representing the strike event, for
+	// example an event decoded with DecodeEvent. The timestamp is not represented by the registers.
+	InjectEvent(event StrikeEvent)
+}
+
+// Create a instance of the AS3935 module backed by 64 in-memory registers initialized to the power-up defaults.
+// The module must be opened like a real one before use, the register options are applied on Open.
+func NewMockModule(opts ...Option) MockModule {
+	memory := internal.NewMemoryDevice()
+
+	return &mockModule{
+		module: newModule(memory, 0x03, newOptions(opts)),
+		memory: memory,
+	}
+}
+
+type mockModule struct {
+	*module
+	memory *internal.MemoryDevice
+}
+
+func (m *mockModule) SetRegister(offset, value uint8) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.memory.SetRegister(offset, value)
+}
+
+func (m *mockModule) GetRegister(offset uint8) uint8 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.memory.GetRegister(offset)
+}
+
+func (m *mockModule) InjectEvent(event StrikeEvent) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var (
+		distance = uint8(0x3F)
+		energy   = uint32(event.Energy * strikeEnergyDivisor)
+	)
+
+	switch {
+	case event.DistanceKm == 0:
+		distance = 0x01
+	case event.DistanceKm > 0 && event.DistanceKm < 0x3F:
+		distance = uint8(event.DistanceKm)
+	}
+
+	m.memory.SetRegister(RegisterInterrupt, m.memory.GetRegister(RegisterInterrupt)&0xF0|uint8(event.Type)&0x0F)
+	m.memory.SetRegister(RegisterDistance, distance)
+	m.memory.SetRegister(RegisterEnergyL, uint8(energy))
+	m.memory.SetRegister(RegisterEnergyM, uint8(energy>>8))
+	m.memory.SetRegister(RegisterEnergyMM, uint8(energy>>16)&0x1F)
+}
@@ -0,0 +1,19 @@
+package as3935go
+
+import "testing"
+
+// Create an opened mock module for the tests.
+func openMockModule(tb testing.TB, opts ...Option) *mockModule {
+	tb.Helper()
+
+	m := NewMockModule(opts...).(*mockModule)
+	if err := m.Open(); err != nil {
+		tb.Fatalf("failed to open the mock module: %s", err)
+	}
+
+	tb.Cleanup(func() {
+		_ = m.Close()
+	})
+
+	return m
+}
@@ -0,0 +1,158 @@
+package as3935go
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+const (
+	// The interval between the interrupt register reads of the noise floor adaptation loop.
+	noiseFloorPollInterval = time.Duration(500) * time.Millisecond
+
+	// The period without the NoiseLevelTooHigh interrupts after which the noise floor level is lowered.
+	noiseFloorQuietPeriod = time.Duration(5) * time.Minute
+
+	noiseFloorLevelStep uint8 = 0x10
+	noiseFloorLevelMax  uint8 = 0x70
+)
+
+// The noise floor level thresholds in µVrms for the NF_LEV register values in the outdoor and indoor mode.
+var (
+	outdoorNoiseFloorMicroVrms = [8]uint32{390, 630, 860, 1100, 1140, 1570, 1800, 2000}
+	indoorNoiseFloorMicroVrms  = [8]uint32{28, 45, 62, 78, 95, 112, 130, 146}
+)
+
+func (m *module) GetNoiseFloorMicroVrms() (uint32, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	registerPower, err := m.i2c.RegRead(RegisterPower)
+	if err != nil {
+		return 0, fmt.Errorf("as3935: failed to read the analog frontend register: %w", err)
+	}
+
+	registerNoiseFloor, err := m.i2c.RegRead(RegisterNoiseFloor)
+	if err != nil {
+		return 0, fmt.Errorf("as3935: failed to read the noise floor level register: %w", err)
+	}
+
+	index := (registerNoiseFloor & 0x70) >> 4
+
+	switch AnalogFrontEnd(registerPower & 0x3E) {
+	case Indoor:
+		return indoorNoiseFloorMicroVrms[index], nil
+	case Outdoor:
+		return outdoorNoiseFloorMicroVrms[index], nil
+	default:
+		return 0, fmt.Errorf("as3935: the analog frontend had a corrupted value: %w", ErrCorruptedRegister)
+	}
+}
+
+func (m *module) AutoAdjustNoiseFloor(ctx context.Context) (<-chan NoiseFloorLevel, error) {
+	m.mu.Lock()
+	register, err := m.i2c.RegRead(RegisterNoiseFloor)
+	m.mu.Unlock()
+
+	if err != nil {
+		return nil, fmt.Errorf("as3935: failed to read the noise floor level register: %w", err)
+	}
+
+	var (
+		level   = register & 0x70
+		minimum = level
+		levels  = make(chan NoiseFloorLevel)
+	)
+
+	go func() {
+		defer close(levels)
+
+		lastAdjustment := m.options.clock.Now()
+		for {
+			if err := m.sleepContext(ctx, noiseFloorPollInterval); err != nil {
+				return
+			}
+
+			if err := m.awaitPollSlot(ctx); err != nil {
+				return
+			}
+
+			interrupt, err := m.GetInterruptSourceContext(ctx)
+			if err != nil {
+				continue
+			}
+
+			next := level
+			if interrupt == NoiseLevelTooHigh {
+				lastAdjustment = m.options.clock.Now()
+				if level < noiseFloorLevelMax {
+					next = level + noiseFloorLevelStep
+				}
+			} else if level > minimum && m.options.clock.Now().Sub(lastAdjustment) >= noiseFloorQuietPeriod {
+				lastAdjustment = m.options.clock.Now()
+				next = level - noiseFloorLevelStep
+			}
+
+			if next == level {
+				continue
+			}
+
+			if err := m.SetNoiseFloorLevel(NoiseFloorLevel(next)); err != nil {
+				continue
+			}
+
+			level = next
+
+			select {
+			case levels <- NoiseFloorLevel(level):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return levels, nil
+}
+
+// The Indoor and Outdoor noise floor constants share the same NF_LEV values, so the level is interpreted in
+// the scale of the given analog front end. The analog front end is written first and read back after the
+// noise floor level is written, a mismatch is logged as the "noise_floor.environment_mismatch" warning if
+// the logger is configured and reported with the ErrWriteVerifyFailed error.
+func (m *module) SetNoiseFloorForEnvironment(afe AnalogFrontEnd, level NoiseFloorLevel) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	switch level {
+	case 0x00, 0x10, 0x20, 0x30, 0x40, 0x50, 0x60, 0x70:
+	default:
+		return fmt.Errorf("as3935: the provided noise floor level value is out of range: %w", ErrValueOutOfRange)
+	}
+
+	if err := m.setAnalogFrontEnd(afe); err != nil {
+		return err
+	}
+
+	if err := m.setNoiseFloorLevel(level); err != nil {
+		return err
+	}
+
+	register, err := m.i2c.RegRead(RegisterPower)
+	if err != nil {
+		return fmt.Errorf("as3935: failed to read the analog frontend register: %w", err)
+	}
+
+	if actual := AnalogFrontEnd(register & 0x3E); actual != afe {
+		if m.options.logger != nil {
+			m.options.logger.LogAttrs(context.Background(), slog.LevelWarn, "noise_floor.environment_mismatch",
+				slog.String("intended", afe.String()),
+				slog.String("actual", actual.String()),
+				slog.String("level", level.String()),
+				slog.Int("address", m.address))
+		}
+
+		return fmt.Errorf("as3935: the analog frontend %s does not match the intended environment %s: %w", actual, afe, ErrWriteVerifyFailed)
+	}
+
+	return nil
+}
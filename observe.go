@@ -0,0 +1,125 @@
+package as3935go
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/Krzysztofz01/as3935-go/internal"
+)
+
+// The register write recorded by the ObserveTransport. The plain register writes are recorded with the 0xFF mask.
+type ObservedWrite struct {
+	Offset uint8
+	Value  uint8
+	Mask   uint8
+}
+
+// The dry-run transport recording the register writes without passing them to the hardware. The reads are
+// served from the in-memory shadow of the registers, which holds the last written values. The registers
+// which were not written yet are read from the wrapped transport, or from the power-up defaults when the
+// transport is used standalone. The direct commands are recorded, but their effects are not emulated.
+type ObserveTransport struct {
+	transport Transport
+	shadow    [internal.MaxRegisterOffset + 1]uint8
+	written   [internal.MaxRegisterOffset + 1]bool
+	writes    []ObservedWrite
+	mu        sync.Mutex
+}
+
+// Create a new dry-run transport wrapping the transport. The Open and Close operations and the reads of the
+// registers not written yet are passed to the wrapped transport. A nil transport creates a standalone
+// transport with the registers set to the power-up defaults.
+func NewObserveTransport(transport Transport) *ObserveTransport {
+	t := &ObserveTransport{
+		transport: transport,
+		writes:    make([]ObservedWrite, 0),
+	}
+
+	if transport == nil {
+		copy(t.shadow[:], internal.DefaultRegisters[:])
+	}
+
+	return t
+}
+
+// Get the copy of the register writes recorded in the order they were performed.
+func (t *ObserveTransport) Writes() []ObservedWrite {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	writes := make([]ObservedWrite, len(t.writes))
+	copy(writes, t.writes)
+	return writes
+}
+
+// Discard the recorded register writes and the shadow values of the written registers.
+func (t *ObserveTransport) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.writes = t.writes[:0]
+	t.written = [internal.MaxRegisterOffset + 1]bool{}
+
+	if t.transport == nil {
+		copy(t.shadow[:], internal.DefaultRegisters[:])
+	}
+}
+
+func (t *ObserveTransport) Open() error {
+	if t.transport == nil {
+		return nil
+	}
+
+	return t.transport.Open()
+}
+
+func (t *ObserveTransport) Close() error {
+	if t.transport == nil {
+		return nil
+	}
+
+	return t.transport.Close()
+}
+
+func (t *ObserveTransport) RegRead(offset uint8) (uint8, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.regRead(offset)
+}
+
+func (t *ObserveTransport) RegWrite(offset, value uint8) error {
+	return t.RegWriteMasked(offset, value, 0xFF)
+}
+
+func (t *ObserveTransport) RegWriteMasked(offset, value, mask uint8) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	register, err := t.regRead(offset)
+	if err != nil {
+		return fmt.Errorf("as3935: failed to read the register for masked writing: %w", err)
+	}
+
+	t.writes = append(t.writes, ObservedWrite{
+		Offset: offset,
+		Value:  value,
+		Mask:   mask,
+	})
+
+	t.shadow[offset] = (register & ^mask) | (value & mask)
+	t.written[offset] = true
+	return nil
+}
+
+func (t *ObserveTransport) regRead(offset uint8) (uint8, error) {
+	if offset > internal.MaxRegisterOffset {
+		return 0x00, fmt.Errorf("as3935: the offset is out of the module register range: %w", ErrValueOutOfRange)
+	}
+
+	if t.written[offset] || t.transport == nil {
+		return t.shadow[offset], nil
+	}
+
+	return t.transport.RegRead(offset)
+}
@@ -0,0 +1,27 @@
+package as3935go
+
+import "errors"
+
+// The observer of the values read from and written to the module, which allows to export metrics without
+// polling the module independently. The observer methods are called while the module lock is held, so
+// they must not call the module methods. The getters are sharing the lock, so the methods can be called
+// concurrently.
+type Observer interface {
+	// Called with every interrupt type read from the INT register, including NoResults.
+	ObserveInterrupt(interrupt InterruptType)
+
+	// Called with every lightning strike event read via ReadStrikeEvent or Watch.
+	ObserveStrike(event StrikeEvent)
+
+	// Called with the noise floor level read from or written to the NF_LEV register.
+	ObserveNoiseFloorLevel(level NoiseFloorLevel)
+
+	// Called with every error of the underlying transport which wraps the ErrBusFailure error.
+	ObserveBusError(err error)
+}
+
+func (m *module) observeBusError(err error) {
+	if errors.Is(err, ErrBusFailure) {
+		m.options.observer.ObserveBusError(err)
+	}
+}
@@ -0,0 +1,259 @@
+package as3935go
+
+import (
+	"io"
+	"log/slog"
+	"time"
+)
+
+// The configuration option of the module passed to the module constructors.
+type Option func(*options)
+
+// The strategy of reading the registers from the 0x00-0x08 block over the i2c bus.
+type ReadStrategy uint8
+
+const (
+	// Read the whole 0x00-0x08 block and take the requested register from it. The AS3935 does not return
+	// the correct value when a single register from the block is read via i2c, so this is the default.
+	BulkRead ReadStrategy = 0x00
+
+	// Read the requested register directly with a single byte read, for the boards without the issue.
+	DirectRead ReadStrategy = 0x01
+)
+
+type options struct {
+	debugOut          io.Writer
+	analogFrontEnd    *AnalogFrontEnd
+	noiseFloorLevel   *NoiseFloorLevel
+	watchdogThreshold *WatchdogThreshold
+	spikeRejection    *SpikeRejection
+	retryAttempts     int
+	retryBackoff      time.Duration
+	settleDelay       time.Duration
+	minPollInterval   time.Duration
+	operationTimeout  time.Duration
+	readStrategy      ReadStrategy
+	verifiedWrites    bool
+	recalibration     time.Duration
+	commandKey        uint8
+	strict            bool
+	strictInterrupt   bool
+	withoutLocking    bool
+	statistics        *Statistics
+	observer          Observer
+	logger            *slog.Logger
+	clock             Clock
+}
+
+func newOptions(opts []Option) options {
+	o := options{
+		settleDelay:     DefaultSettleDelay,
+		minPollInterval: DefaultMinPollInterval,
+		commandKey:      DirectCommandValue,
+		clock:           systemClock{},
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// Log the state of the registers on register reads and writes into the provided writer. The writer is
+// flushed after every operation if it implements the Flush() error method, like the bufio.Writer.
+func WithDebugOutput(debugOut io.Writer) Option {
+	return func(o *options) {
+		o.debugOut = debugOut
+	}
+}
+
+// Retry the register operations which failed with the ErrBusFailure error. The operation is performed at
+// most attempts times with the backoff delay between the attempts.
+func WithRetry(attempts int, backoff time.Duration) Option {
+	return func(o *options) {
+		o.retryAttempts = attempts
+		o.retryBackoff = backoff
+	}
+}
+
+// Set the delay awaited after the operations which require the module to settle, like the power up
+// sequence or before reading the interrupt register. The default delay is the DefaultSettleDelay (5ms).
+func WithSettleDelay(delay time.Duration) Option {
+	return func(o *options) {
+		o.settleDelay = delay
+	}
+}
+
+// Set the minimum interval between the register reads of the polling helpers, like WaitForInterrupt,
+// LogInterrupts and WatchDistance. The shorter polling intervals passed to the helpers are extended to the
+// minimum and the reads of the helpers running at once are spaced by the minimum as well. The default is the DefaultMinPollInterval (10ms) and a zero interval disables the limit.
+func WithMinPollInterval(interval time.Duration) Option {
+	return func(o *options) {
+		o.minPollInterval = interval
+	}
+}
+
+// Set the strategy of reading the registers from the 0x00-0x08 block. The option only affects the i2c
+// devices created by NewModule, the transports passed to NewModuleWithTransport are
+// reading the registers on their own. The default strategy is BulkRead.
+func WithReadStrategy(strategy ReadStrategy) Option {
+	return func(o *options) {
+		o.readStrategy = strategy
+	}
+}
+
+// Read back the register after every masked register write and fail with the ErrWriteVerifyFailed error
+// when the written bits do not match.
+func WithVerifiedWrites() Option {
+	return func(o *options) {
+		o.verifiedWrites = true
+	}
+}
+
+// Fail the register operations which are not completed within the timeout with the ErrBusTimeout error.
+// The timed out operation can not be aborted, so it keeps running in the background and the following
+// operations are waiting for it. A zero timeout disables the limit, which is the default.
+func WithOperationTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.operationTimeout = timeout
+	}
+}
+
+// Set the interval after which Resume performs the full power up calibration sequence again. Within the
+// interval Resume only powers up the module and restores the state captured by Suspend. A zero interval,
+// which is the default, recalibrates on every Resume.
+func WithRecalibrationInterval(interval time.Duration) Option {
+	return func(o *options) {
+		o.recalibration = interval
+	}
+}
+
+// Set the value written to the PRESET_DEFAULT and CALIB_RCO direct command registers to trigger the command.
+// The default is the DirectCommandValue (0x96) defined by the datasheet and changing it is almost never
+// correct, the option is only intended for the bring-up of the chips responding to a different value.
+func WithCommandKey(key uint8) Option {
+	return func(o *options) {
+		o.commandKey = key
+	}
+}
+
+// Fail the lightning distance and the strike energy getters with the ErrNoLightningEvent error if the last
+// interrupt read via the module is not a lightning. The INT register is cleared on read, so the getters check
+// the interrupt type decoded by the last read instead of reading the register again. By default the registers
+// are read as they are.
+func WithStrictMode() Option {
+	return func(o *options) {
+		o.strict = true
+	}
+}
+
+// Fail the interrupt reads with the ErrCorruptedRegister error when the INT register holds a value not defined
+// by the datasheet. By default such values are reported as the InterruptUnknown. The option is independent
+// of WithStrictMode.
+func WithStrictInterrupt() Option {
+	return func(o *options) {
+		o.strictInterrupt = true
+	}
+}
+
+// Disable the locking of the module methods to avoid its overhead in a single goroutine.
+//
+// WARNING: The module is NOT safe for the concurrent use with this option. It must not be used together
+// with Watch, Start, AutoAdjustNoiseFloor, WatchDistance or any other background goroutine of the module.
+func WithoutLocking() Option {
+	return func(o *options) {
+		o.withoutLocking = true
+	}
+}
+
+// Record the lightning strikes read via ReadStrikeEvent or Watch into the statistics collector.
+func WithStatistics(statistics *Statistics) Option {
+	return func(o *options) {
+		o.statistics = statistics
+	}
+}
+
+// Report the interrupts, strikes, noise floor levels and bus errors to the observer.
+func WithObserver(observer Observer) Option {
+	return func(o *options) {
+		o.observer = observer
+	}
+}
+
+// Emit the structured "register.read", "register.write", "interrupt" and "calibration" log events via the
+// logger. The logger is an alternative to the debug output, both can be used at the same time.
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// Use the clock for the settle delays, the polling intervals, the retry backoff and the timestamps of the
+// module, as well as for the window of the statistics collector attached with WithStatistics and of the edge
+// counter created with NewEdgeCounter and passed to MeasureAntennaFrequency. Only the operation timeout set
+// with WithOperationTimeout uses the time package, because it guards against a hung bus. A nil clock is ignored.
+func WithClock(clock Clock) Option {
+	return func(o *options) {
+		if clock != nil {
+			o.clock = clock
+		}
+	}
+}
+
+// Set the analog front end on Open. The register options are applied on Open in the following order:
+// analog front end, noise floor level, watchdog threshold and spike rejection.
+func WithAnalogFrontEnd(model AnalogFrontEnd) Option {
+	return func(o *options) {
+		o.analogFrontEnd = &model
+	}
+}
+
+// Set the noise floor level on Open, after the analog front end.
+func WithNoiseFloorLevel(level NoiseFloorLevel) Option {
+	return func(o *options) {
+		o.noiseFloorLevel = &level
+	}
+}
+
+// Set the watchdog threshold on Open, after the noise floor level.
+func WithWatchdogThreshold(threshold WatchdogThreshold) Option {
+	return func(o *options) {
+		o.watchdogThreshold = &threshold
+	}
+}
+
+// Set the spike rejection on Open, after the watchdog threshold.
+func WithSpikeRejection(rejection SpikeRejection) Option {
+	return func(o *options) {
+		o.spikeRejection = &rejection
+	}
+}
+
+// Apply the register options in the documented order. The module lock must be held by the caller.
+func (m *module) applyOptions() error {
+	if m.options.analogFrontEnd != nil {
+		if err := m.setAnalogFrontEnd(*m.options.analogFrontEnd); err != nil {
+			return err
+		}
+	}
+
+	if m.options.noiseFloorLevel != nil {
+		if err := m.setNoiseFloorLevel(*m.options.noiseFloorLevel); err != nil {
+			return err
+		}
+	}
+
+	if m.options.watchdogThreshold != nil {
+		if err := m.setWatchdogThreshold(*m.options.watchdogThreshold); err != nil {
+			return err
+		}
+	}
+
+	if m.options.spikeRejection != nil {
+		if err := m.setSpikeRejection(*m.options.spikeRejection); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
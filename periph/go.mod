@@ -0,0 +1,12 @@
+module github.com/Krzysztofz01/as3935-go/periph
+
+go 1.21.10
+
+require (
+	github.com/Krzysztofz01/as3935-go v0.0.0
+	periph.io/x/conn/v3 v3.7.0
+)
+
+require golang.org/x/exp v0.0.0-20240506185415-9bf2ced13842 // indirect
+
+replace github.com/Krzysztofz01/as3935-go => ../
@@ -0,0 +1,150 @@
+// Package periph connects the AS3935 module over the periph.io I2C bus. The package is a separate module, so
+// the library itself does not depend on periph.io.
+package periph
+
+import (
+	"fmt"
+	"sync"
+
+	as3935go "github.com/Krzysztofz01/as3935-go"
+	"periph.io/x/conn/v3/i2c"
+)
+
+const (
+	readBufferSize    uint8 = 9
+	maxRegisterOffset uint8 = 0x3F
+)
+
+// Create a instance of the AS3935 module communicating over the provided periph.io I2C bus. The bus is owned
+// by the caller and is not closed by the module, Open and Close are only changing the connection state.
+// The registers are read with the BulkRead strategy, use NewTransport with the as3935go.NewModuleWithTransport
+// constructor to select the strategy. The options are applied to the module, the register options are applied on Open.
+func NewModuleFromBus(bus i2c.Bus, address uint16, opts ...as3935go.Option) (as3935go.Module, error) {
+	transport, err := NewTransport(bus, address, as3935go.BulkRead)
+	if err != nil {
+		return nil, err
+	}
+
+	return as3935go.NewModuleWithTransport(transport, int(address), opts...)
+}
+
+// Create a new transport communicating over the periph.io bus. The bus is owned by the caller, so the Open and
+// Close operations are only changing the connection state of the transport. The BulkRead strategy enables the
+// workaround reading the whole 0x00-0x08 block for every register from the block.
+func NewTransport(bus i2c.Bus, address uint16, strategy as3935go.ReadStrategy) (as3935go.Transport, error) {
+	if bus == nil {
+		return nil, fmt.Errorf("as3935: invalid i2c bus specified")
+	}
+
+	if int(address) < as3935go.MinAddress || int(address) > as3935go.MaxAddress {
+		return nil, fmt.Errorf("as3935: the i2c address 0x%02x is not supported by the module: %w", address, as3935go.ErrValueOutOfRange)
+	}
+
+	return &transport{
+		Bus:       bus,
+		Address:   address,
+		BulkRead:  strategy == as3935go.BulkRead,
+		Connected: false,
+	}, nil
+}
+
+// The mutex guards the connection state and serializes the bus transactions of the module.
+type transport struct {
+	Bus       i2c.Bus
+	Address   uint16
+	BulkRead  bool
+	Connected bool
+	mu        sync.Mutex
+}
+
+// The name of the bus is reported as the device path of the module.
+func (t *transport) String() string {
+	return t.Bus.String()
+}
+
+func (t *transport) Open() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.Connected {
+		return fmt.Errorf("as3935: the module is already connected: %w", as3935go.ErrAlreadyConnected)
+	}
+
+	t.Connected = true
+	return nil
+}
+
+func (t *transport) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.Connected {
+		return fmt.Errorf("as3935: the module is not connected: %w", as3935go.ErrNotConnected)
+	}
+
+	t.Connected = false
+	return nil
+}
+
+// The AS3935 IC does not return the correct value when a single register from the 0x00-0x08 block is read
+// via i2c. As a workaround the whole block is read starting from the 0x00 offset and the requested register
+// is taken from the buffer. The registers above the block, and all registers when the workaround is
+// disabled, are read directly with a single byte read.
+func (t *transport) RegRead(offset uint8) (uint8, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.Connected {
+		return 0x00, fmt.Errorf("as3935: the module is not connected: %w", as3935go.ErrNotConnected)
+	}
+
+	if offset > maxRegisterOffset {
+		return 0x00, fmt.Errorf("as3935: the offset is out of the module register range: %w", as3935go.ErrValueOutOfRange)
+	}
+
+	if offset >= readBufferSize || !t.BulkRead {
+		buffer := [1]uint8{}
+		if err := t.Bus.Tx(t.Address, []uint8{offset}, buffer[:]); err != nil {
+			return 0x00, fmt.Errorf("as3935: failed to read the value at the given offset via i2c: %w: %w", as3935go.ErrBusFailure, err)
+		}
+
+		return buffer[0], nil
+	}
+
+	buffer := [readBufferSize]uint8{}
+	if err := t.Bus.Tx(t.Address, []uint8{0x00}, buffer[:]); err != nil {
+		return 0x00, fmt.Errorf("as3935: failed to read the value at the given offset via i2c: %w: %w", as3935go.ErrBusFailure, err)
+	}
+
+	return buffer[offset], nil
+}
+
+func (t *transport) RegWrite(offset, value uint8) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.Connected {
+		return fmt.Errorf("as3935: the module is not connected: %w", as3935go.ErrNotConnected)
+	}
+
+	if err := t.Bus.Tx(t.Address, []uint8{offset, value}, nil); err != nil {
+		return fmt.Errorf("as3935: failed to write the value at the given offset via i2c: %w: %w", as3935go.ErrBusFailure, err)
+	}
+
+	return nil
+}
+
+func (t *transport) RegWriteMasked(offset, value, mask uint8) error {
+	register, err := t.RegRead(offset)
+	if err != nil {
+		return fmt.Errorf("as3935: failed to read the register for masked writing: %w", err)
+	}
+
+	register = (register & ^mask) | (value & mask)
+
+	if err := t.RegWrite(offset, register); err != nil {
+		return fmt.Errorf("as3935: failed to write the register for masked writing: %w", err)
+	}
+
+	return nil
+}
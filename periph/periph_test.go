@@ -0,0 +1,99 @@
+package periph
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+
+	as3935go "github.com/Krzysztofz01/as3935-go"
+	"periph.io/x/conn/v3/physic"
+)
+
+// The in-memory I2C bus serving the register reads and writes of a single device.
+type memoryBus struct {
+	registers [maxRegisterOffset + 1]uint8
+	mu        sync.Mutex
+}
+
+func (b *memoryBus) Tx(addr uint16, w, r []byte) error {
+	// NOTE: Yielding lets the concurrent operations interleave even on a single processor
+	defer runtime.Gosched()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(r) == 0 {
+		b.registers[w[0]] = w[1]
+		return nil
+	}
+
+	copy(r, b.registers[w[0]:])
+	return nil
+}
+
+func (b *memoryBus) SetSpeed(f physic.Frequency) error {
+	return nil
+}
+
+func (b *memoryBus) String() string {
+	return "memory"
+}
+
+// The test is meant to be run with the race detector, which reports the buffers shared between the operations.
+func TestConcurrentRegisterAccessOverBus(t *testing.T) {
+	for _, opts := range [][]as3935go.Option{{}, {as3935go.WithoutLocking()}} {
+		bus := &memoryBus{}
+		bus.registers[as3935go.RegisterDistance] = 0x0E
+
+		m, err := NewModuleFromBus(bus, 0x03, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := m.Open(); err != nil {
+			t.Fatalf("failed to open the module: %s", err)
+		}
+
+		var wg sync.WaitGroup
+		for index := 0; index < 8; index += 1 {
+			wg.Add(1)
+			go func(index int) {
+				defer wg.Done()
+
+				for iteration := 0; iteration < 100; iteration += 1 {
+					if distance, err := m.GetLightningDistanceKm(); err != nil || distance != 14 {
+						t.Errorf("expected the 14 km distance, got %d and %v", distance, err)
+						return
+					}
+
+					if _, err := m.DumpRegisters(); err != nil {
+						t.Errorf("failed to dump the registers: %s", err)
+						return
+					}
+
+					if err := m.SetTuningCapacitance(as3935go.TuningCapacitance(index)); err != nil {
+						t.Errorf("failed to set the tuning capacitance: %s", err)
+						return
+					}
+				}
+			}(index)
+		}
+
+		wg.Wait()
+
+		if err := m.Close(); err != nil {
+			t.Fatalf("failed to close the module: %s", err)
+		}
+	}
+}
+
+func TestNewModuleFromBusReportsTheBusName(t *testing.T) {
+	m, err := NewModuleFromBus(&memoryBus{}, 0x03)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if path := m.DevicePath(); path != "memory" {
+		t.Fatalf("expected the memory device path, got %s", path)
+	}
+}
@@ -0,0 +1,67 @@
+package as3935go
+
+import "fmt"
+
+func (m *module) SetAnalogFrontEndWithPrev(model AnalogFrontEnd) (AnalogFrontEnd, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	register, err := m.i2c.RegRead(RegisterPower)
+	if err != nil {
+		return 0x00, fmt.Errorf("as3935: failed to read the previous analog frontend: %w", err)
+	}
+
+	if err := m.setAnalogFrontEnd(model); err != nil {
+		return 0x00, err
+	}
+
+	return AnalogFrontEnd(register & 0x3E), nil
+}
+
+func (m *module) SetNoiseFloorLevelWithPrev(level NoiseFloorLevel) (NoiseFloorLevel, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	register, err := m.i2c.RegRead(RegisterNoiseFloor)
+	if err != nil {
+		return 0x00, fmt.Errorf("as3935: failed to read the previous noise floor level: %w", err)
+	}
+
+	if err := m.setNoiseFloorLevel(level); err != nil {
+		return 0x00, err
+	}
+
+	return NoiseFloorLevel(register & 0x70), nil
+}
+
+func (m *module) SetWatchdogThresholdWithPrev(threshold WatchdogThreshold) (WatchdogThreshold, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	register, err := m.i2c.RegRead(RegisterNoiseFloor)
+	if err != nil {
+		return 0x00, fmt.Errorf("as3935: failed to read the previous watchdog threshold: %w", err)
+	}
+
+	if err := m.setWatchdogThreshold(threshold); err != nil {
+		return 0x00, err
+	}
+
+	return WatchdogThreshold(register & 0x0F), nil
+}
+
+func (m *module) SetSpikeRejectionWithPrev(rejection SpikeRejection) (SpikeRejection, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	register, err := m.i2c.RegRead(RegisterStatistics)
+	if err != nil {
+		return 0x00, fmt.Errorf("as3935: failed to read the previous spike rejection: %w", err)
+	}
+
+	if err := m.setSpikeRejection(rejection); err != nil {
+		return 0x00, err
+	}
+
+	return SpikeRejection(register & 0x0F), nil
+}
@@ -0,0 +1,158 @@
+package as3935go
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Krzysztofz01/as3935-go/internal"
+)
+
+// The heuristic classification of the chip connected as the AS3935 module.
+type ChipVariant uint8
+
+const (
+	// The variant has not been detected yet or the detection failed.
+	VariantUnknown ChipVariant = 0x00
+
+	// The chip settles all registers, including the reserved bits, to the datasheet defaults.
+	VariantGenuine ChipVariant = 0x01
+
+	// The chip responds, but settles some registers to values different from the datasheet defaults.
+	VariantClonedSuspect ChipVariant = 0x02
+)
+
+func (v ChipVariant) String() string {
+	switch v {
+	case VariantUnknown:
+		return "Unknown"
+	case VariantGenuine:
+		return "Genuine"
+	case VariantClonedSuspect:
+		return "ClonedSuspect"
+	default:
+		return fmt.Sprintf("ChipVariant(0x%02x)", uint8(v))
+	}
+}
+
+// The registers compared with the defaults by the variant detection, with the masks including the reserved bits.
+var variantRegisters = []struct {
+	offset uint8
+	mask   uint8
+}{
+	{RegisterPower, 0xFF},
+	{RegisterNoiseFloor, 0xFF},
+	{RegisterStatistics, 0xFF},
+	{RegisterInterrupt, 0xE0},
+	{RegisterTuning, 0xFF},
+}
+
+func (m *module) Variant() ChipVariant {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.variant
+}
+
+func (m *module) DetectVariant() (ChipVariant, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	variant, err := m.detectVariant()
+	if err != nil {
+		return VariantUnknown, err
+	}
+
+	m.variant = variant
+	return variant, nil
+}
+
+// The configuration registers and the PWD bit are captured before and restored after the detection. The
+// preset also resets the RCO calibration, so the oscillators are calibrated before the state is restored.
+func (m *module) detectVariant() (ChipVariant, error) {
+	snapshot, err := m.snapshotState()
+	if err != nil {
+		return VariantUnknown, fmt.Errorf("as3935: failed to capture the state before the variant detection: %w", err)
+	}
+
+	registerPower, err := m.i2c.RegRead(RegisterPower)
+	if err != nil {
+		return VariantUnknown, fmt.Errorf("as3935: failed to read the power register before the variant detection: %w", err)
+	}
+
+	if err := m.i2c.RegWrite(RegisterPresetDefault, m.options.commandKey); err != nil {
+		return VariantUnknown, fmt.Errorf("as3935: failed to set value to the preset default direct command register: %w", err)
+	}
+
+	registers, err := m.dumpRegisters()
+	if err != nil {
+		return VariantUnknown, fmt.Errorf("as3935: failed to read the registers during the variant detection: %w", err)
+	}
+
+	if err := m.calibrateRCOContext(context.Background()); err != nil {
+		return VariantUnknown, fmt.Errorf("as3935: failed to calibrate the oscillators after the variant detection: %w", err)
+	}
+
+	if err := m.restoreState(snapshot); err != nil {
+		return VariantUnknown, fmt.Errorf("as3935: failed to restore the state after the variant detection: %w", err)
+	}
+
+	// NOTE: The PWD bit is not part of the state snapshot and is cleared by the preset
+	if err := m.i2c.RegWriteMasked(RegisterPower, registerPower, 0x01); err != nil {
+		return VariantUnknown, fmt.Errorf("as3935: failed to restore the power state after the variant detection: %w", err)
+	}
+
+	for _, entry := range variantRegisters {
+		if registers[entry.offset]&entry.mask != internal.DefaultRegisters[entry.offset]&entry.mask {
+			return VariantClonedSuspect, nil
+		}
+	}
+
+	return VariantGenuine, nil
+}
+
+func (m *module) Probe() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	registers, err := m.dumpRegisters()
+	if err != nil {
+		return fmt.Errorf("as3935: failed to read the registers during the probe: %w", err)
+	}
+
+	allLow, allHigh := true, true
+	for _, register := range registers {
+		allLow = allLow && register == 0x00
+		allHigh = allHigh && register == 0xFF
+	}
+
+	if allLow || allHigh {
+		return fmt.Errorf("as3935: the registers are floating during the probe: %w", ErrNoDevice)
+	}
+
+	if registers[RegisterPower]&0xC0 != 0x00 {
+		return fmt.Errorf("as3935: the reserved bits of the power register are set during the probe: %w", ErrNoDevice)
+	}
+
+	// NOTE: The tuning capacitance is benign to change, the original value is restored afterwards
+	original := registers[RegisterTuning] & 0x0F
+	probe := original ^ 0x0F
+
+	if err := m.i2c.RegWriteMasked(RegisterTuning, probe, 0x0F); err != nil {
+		return fmt.Errorf("as3935: failed to write the tuning capacitance during the probe: %w", err)
+	}
+
+	register, err := m.i2c.RegRead(RegisterTuning)
+	if err != nil {
+		return fmt.Errorf("as3935: failed to read the tuning capacitance during the probe: %w", err)
+	}
+
+	if err := m.i2c.RegWriteMasked(RegisterTuning, original, 0x0F); err != nil {
+		return fmt.Errorf("as3935: failed to restore the tuning capacitance during the probe: %w", err)
+	}
+
+	if register&0x0F != probe {
+		return fmt.Errorf("as3935: the tuning capacitance did not round-trip during the probe: %w", ErrNoDevice)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package as3935go
+
+import (
+	"testing"
+	"time"
+)
+
+func TestProbeKeepsThePendingEvent(t *testing.T) {
+	m := openMockModule(t)
+	m.InjectEvent(StrikeEvent{Type: LightningInterrupt, DistanceKm: 14})
+
+	if err := m.Probe(); err != nil {
+		t.Fatalf("failed to probe the module: %s", err)
+	}
+
+	if distance, err := m.GetLightningDistanceKm(); err != nil || distance != 14 {
+		t.Fatalf("expected the 14 km distance after the probe, got %d and %v", distance, err)
+	}
+
+	if variant := m.Variant(); variant != VariantUnknown {
+		t.Fatalf("expected the variant to stay unknown after the probe, got %s", variant)
+	}
+}
+
+func TestDetectVariantRecalibratesAndRestoresTheState(t *testing.T) {
+	m := openMockModule(t, WithClock(NewFakeClock(time.Unix(0, 0))))
+
+	if err := m.PowerSwitch(true); err != nil {
+		t.Fatalf("failed to power up the module: %s", err)
+	}
+
+	if err := m.SetAnalogFrontEnd(Outdoor); err != nil {
+		t.Fatalf("failed to set the analog front end: %s", err)
+	}
+
+	variant, err := m.DetectVariant()
+	if err != nil {
+		t.Fatalf("failed to detect the variant: %s", err)
+	}
+
+	if variant != VariantGenuine || m.Variant() != VariantGenuine {
+		t.Fatalf("expected the genuine variant, got %s and %s", variant, m.Variant())
+	}
+
+	status, err := m.GetCalibrationStatus()
+	if err != nil {
+		t.Fatalf("failed to get the calibration status: %s", err)
+	}
+
+	if !status.TRCODone || !status.SRCODone {
+		t.Fatalf("expected the oscillators to be calibrated after the detection, got %+v", status)
+	}
+
+	if afe, err := m.GetAnalogFrontEnd(); err != nil || afe != Outdoor {
+		t.Fatalf("expected the outdoor analog front end to be restored, got %s and %v", afe, err)
+	}
+
+	if powered, err := m.IsPoweredUp(); err != nil || !powered {
+		t.Fatalf("expected the module to stay powered up, got %t and %v", powered, err)
+	}
+}
@@ -0,0 +1,74 @@
+package as3935go
+
+import (
+	"fmt"
+
+	"github.com/Krzysztofz01/as3935-go/internal"
+)
+
+// The offsets of the module registers. The comments list the fields stored in the register.
+const (
+	// AFE_GB, PWD
+	RegisterPower uint8 = 0x00
+	// NF_LEV, WDTH
+	RegisterNoiseFloor uint8 = 0x01
+	// CL_STAT, MIN_NUM_LIGH, SREJ
+	RegisterStatistics uint8 = 0x02
+	// LCO_FDIV, MASK_DIST, INT
+	RegisterInterrupt uint8 = 0x03
+	// S_LIG_L
+	RegisterEnergyL uint8 = 0x04
+	// S_LIG_M
+	RegisterEnergyM uint8 = 0x05
+	// S_LIG_MM
+	RegisterEnergyMM uint8 = 0x06
+	// DISTANCE
+	RegisterDistance uint8 = 0x07
+	// DISP_LCO, DISP_SRCO, DISP_TRCO, TUN_CAP
+	RegisterTuning uint8 = 0x08
+	// TRCO_CALIB_DONE, TRCO_CALIB_NOK
+	RegisterTRCOCalibration uint8 = 0x3A
+	// SRCO_CALIB_DONE, SRCO_CALIB_NOK
+	RegisterSRCOCalibration uint8 = 0x3B
+)
+
+// The direct command registers. The command is triggered by writing the DirectCommandValue to the register.
+const (
+	// PRESET_DEFAULT, set all registers to the default values
+	RegisterPresetDefault uint8 = 0x3C
+	// CALIB_RCO, calibrate the internal RC oscillators automatically
+	RegisterCalibrateRCO uint8 = 0x3D
+	// The value which triggers the direct command
+	DirectCommandValue uint8 = 0x96
+)
+
+func (m *module) ReadRegister(offset uint8) (uint8, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if offset > internal.MaxRegisterOffset {
+		return 0x00, fmt.Errorf("as3935: the specified register offset is out of range: %w", ErrValueOutOfRange)
+	}
+
+	register, err := m.i2c.RegRead(offset)
+	if err != nil {
+		return 0x00, fmt.Errorf("as3935: failed to read the register: %w", err)
+	}
+
+	return register, nil
+}
+
+func (m *module) WriteRegister(offset, value uint8) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if offset > internal.MaxRegisterOffset {
+		return fmt.Errorf("as3935: the specified register offset is out of range: %w", ErrValueOutOfRange)
+	}
+
+	if err := m.i2c.RegWrite(offset, value); err != nil {
+		return fmt.Errorf("as3935: failed to write the register: %w", err)
+	}
+
+	return nil
+}
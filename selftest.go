@@ -0,0 +1,144 @@
+package as3935go
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Krzysztofz01/as3935-go/internal"
+)
+
+// The result of a single self-test check. The Err is nil if the check passed.
+type SelfTestCheck struct {
+	Name string
+	Err  error
+}
+
+// The results of the self-test checks in the order in which they were performed.
+type SelfTestReport struct {
+	Checks []SelfTestCheck
+}
+
+// Check if all self-test checks passed.
+func (r SelfTestReport) Passed() bool {
+	for _, check := range r.Checks {
+		if check.Err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// The writable fields round-tripped by the self-test with a value different from the default one.
+var selfTestFields = []struct {
+	name   string
+	offset uint8
+	mask   uint8
+	value  uint8
+}{
+	{"AFE_GB", RegisterPower, 0x3E, uint8(Outdoor)},
+	{"NF_LEV", RegisterNoiseFloor, 0x70, uint8(NoiseFloorLevel(0x30))},
+	{"WDTH", RegisterNoiseFloor, 0x0F, uint8(WDTH3)},
+	{"MIN_NUM_LIGH", RegisterStatistics, 0x30, uint8(MinLightning5)},
+	{"SREJ", RegisterStatistics, 0x0F, uint8(SREJ3)},
+	{"LCO_FDIV", RegisterInterrupt, 0xC0, uint8(FrequencyDiv32)},
+	{"MASK_DIST", RegisterInterrupt, 0x20, 0x20},
+	{"TUN_CAP", RegisterTuning, 0x0F, uint8(Tuning64pF)},
+}
+
+// The checks are performed in order: the power up with the calibration flags verification, the RCO
+// calibration, the round-trip of every writable field and the PRESET_DEFAULT defaults verification. The
+// failed checks are reported and do not abort the self-test. The PRESET_DEFAULT direct command is sent at
+// the end regardless of the results and the returned error is only set if it fails.
+func (m *module) SelfTest() (SelfTestReport, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var (
+		ctx    = context.Background()
+		report = SelfTestReport{}
+	)
+
+	check := func(name string, err error) {
+		report.Checks = append(report.Checks, SelfTestCheck{Name: name, Err: err})
+	}
+
+	check("power_up", m.powerUp(ctx))
+	check("calibrate_rco", m.calibrateRCOContext(ctx))
+
+	for _, field := range selfTestFields {
+		check("round_trip_"+field.name, m.roundTripField(field.offset, field.mask, field.value))
+	}
+
+	check("preset_default", m.verifyPresetDefault())
+
+	if err := m.i2c.RegWrite(RegisterPresetDefault, m.options.commandKey); err != nil {
+		return report, fmt.Errorf("as3935: failed to restore the defaults after the self-test: %w", err)
+	}
+
+	return report, nil
+}
+
+// Write the masked value to the register, read it back and compare the masked bits.
+func (m *module) roundTripField(offset, mask, value uint8) error {
+	if err := m.i2c.RegWriteMasked(offset, value, mask); err != nil {
+		return fmt.Errorf("as3935: failed to write the register during the self-test: %w", err)
+	}
+
+	register, err := m.i2c.RegRead(offset)
+	if err != nil {
+		return fmt.Errorf("as3935: failed to read the register during the self-test: %w", err)
+	}
+
+	if register&mask != value&mask {
+		return fmt.Errorf("as3935: the register 0x%02x value 0x%02x did not round-trip during the self-test: %w", offset, register, ErrWriteVerifyFailed)
+	}
+
+	return nil
+}
+
+// Send the PRESET_DEFAULT direct command and compare the configuration registers with the defaults.
+func (m *module) verifyPresetDefault() error {
+	if err := m.i2c.RegWrite(RegisterPresetDefault, m.options.commandKey); err != nil {
+		return fmt.Errorf("as3935: failed to set value to the preset default direct command register: %w", err)
+	}
+
+	registers, err := m.dumpRegisters()
+	if err != nil {
+		return fmt.Errorf("as3935: failed to read the registers after the preset default: %w", err)
+	}
+
+	if mismatches := mismatchingDefaults(registers); len(mismatches) != 0 {
+		offset := mismatches[0]
+		return fmt.Errorf("as3935: the register 0x%02x value 0x%02x is not the default after the preset default: %w", offset, registers[offset], ErrCorruptedRegister)
+	}
+
+	return nil
+}
+
+// Only the configuration fields are compared, the interrupt, energy and distance registers are changing
+// with the detected events and the calibration results are not part of the defaults.
+func (m *module) VerifyDefaults() (bool, []uint8, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	registers, err := m.dumpRegisters()
+	if err != nil {
+		return false, nil, fmt.Errorf("as3935: failed to read the registers for the defaults verification: %w", err)
+	}
+
+	mismatches := mismatchingDefaults(registers)
+	return len(mismatches) == 0, mismatches, nil
+}
+
+// Get the offsets of the registers with the configuration fields not matching the power-up defaults.
+func mismatchingDefaults(registers [9]uint8) []uint8 {
+	mismatches := make([]uint8, 0)
+	for _, entry := range snapshotRegisters {
+		if registers[entry.offset]&entry.mask != internal.DefaultRegisters[entry.offset]&entry.mask {
+			mismatches = append(mismatches, entry.offset)
+		}
+	}
+
+	return mismatches
+}
@@ -0,0 +1,98 @@
+package as3935go
+
+import (
+	"fmt"
+
+	"github.com/Krzysztofz01/as3935-go/internal"
+)
+
+// The version of the state snapshot format. The snapshot starts with the version byte and the count of
+// the entries followed by the offset, mask and value bytes of each entry.
+const snapshotVersion uint8 = 0x01
+
+// The writable configuration fields captured by the snapshot, in the order in which they are restored.
+// The PWD and CL_STAT fields are not captured to avoid powering down or clearing the statistics.
+var snapshotRegisters = []struct {
+	offset uint8
+	mask   uint8
+}{
+	{RegisterPower, 0x3E},
+	{RegisterNoiseFloor, 0x7F},
+	{RegisterStatistics, 0x3F},
+	{RegisterInterrupt, 0xE0},
+	{RegisterTuning, 0xEF},
+}
+
+// The snapshot is also stored on the module and restored by Reconnect.
+func (m *module) SnapshotState() ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshot, err := m.snapshotState()
+	if err != nil {
+		return nil, err
+	}
+
+	m.snapshot = append([]byte(nil), snapshot...)
+	return snapshot, nil
+}
+
+func (m *module) snapshotState() ([]byte, error) {
+	snapshot := make([]byte, 0, 2+3*len(snapshotRegisters))
+	snapshot = append(snapshot, snapshotVersion, uint8(len(snapshotRegisters)))
+
+	for _, entry := range snapshotRegisters {
+		register, err := m.i2c.RegRead(entry.offset)
+		if err != nil {
+			return nil, fmt.Errorf("as3935: failed to read the register for the state snapshot: %w", err)
+		}
+
+		snapshot = append(snapshot, entry.offset, entry.mask, register&entry.mask)
+	}
+
+	return snapshot, nil
+}
+
+// The restored snapshot is also stored on the module and restored by Reconnect.
+func (m *module) RestoreState(b []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.restoreState(b); err != nil {
+		return err
+	}
+
+	m.snapshot = append([]byte(nil), b...)
+	return nil
+}
+
+func (m *module) restoreState(b []byte) error {
+	if len(b) < 2 {
+		return fmt.Errorf("as3935: the state snapshot is too short: %w", ErrValueOutOfRange)
+	}
+
+	if b[0] != snapshotVersion {
+		return fmt.Errorf("as3935: the state snapshot version %d is not supported: %w", b[0], ErrValueOutOfRange)
+	}
+
+	count := int(b[1])
+	if len(b) != 2+3*count {
+		return fmt.Errorf("as3935: the state snapshot length does not match the entries count: %w", ErrValueOutOfRange)
+	}
+
+	for index := 0; index < count; index += 1 {
+		entry := b[2+3*index : 5+3*index]
+		if entry[0] > internal.MaxRegisterOffset {
+			return fmt.Errorf("as3935: the state snapshot register offset is out of range: %w", ErrValueOutOfRange)
+		}
+	}
+
+	for index := 0; index < count; index += 1 {
+		entry := b[2+3*index : 5+3*index]
+		if err := m.i2c.RegWriteMasked(entry[0], entry[2], entry[1]); err != nil {
+			return fmt.Errorf("as3935: failed to restore the register from the state snapshot: %w", err)
+		}
+	}
+
+	return nil
+}
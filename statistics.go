@@ -0,0 +1,85 @@
+package as3935go
+
+import (
+	"sync"
+	"time"
+)
+
+// The window over which the strike rate is calculated, matching the 15 minutes window of the module.
+const statisticsRateWindow = time.Duration(15) * time.Minute
+
+// Create a collector of the recent lightning strikes keeping at most capacity strikes. The collector is
+// attached to the module via the WithStatistics option and is safe for concurrent use.
+func NewStatistics(capacity int) *Statistics {
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	return &Statistics{
+		strikes: make([]StrikeEvent, capacity),
+		next:    0,
+		count:   0,
+		clock:   systemClock{},
+		mu:      sync.RWMutex{},
+	}
+}
+
+// The memory-bounded ring buffer of the recent lightning strikes. The recent strikes are selected relative
+// to the clock of the module the collector is attached to.
+type Statistics struct {
+	strikes []StrikeEvent
+	next    int
+	count   int
+	clock   Clock
+	mu      sync.RWMutex
+}
+
+// Use the clock for selecting the recent strikes.
+func (s *Statistics) setClock(clock Clock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.clock = clock
+}
+
+// Record the event if it is a lightning strike. The oldest strike is overwritten when the buffer is full.
+func (s *Statistics) Record(event StrikeEvent) {
+	if event.Type != LightningInterrupt {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.strikes[s.next] = event
+	s.next = (s.next + 1) % len(s.strikes)
+	if s.count < len(s.strikes) {
+		s.count += 1
+	}
+}
+
+// Get the recorded strikes which occurred within the given duration from now, ordered from the oldest.
+func (s *Statistics) RecentStrikes(d time.Duration) []StrikeEvent {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var (
+		since   = s.clock.Now().Add(-d)
+		strikes = make([]StrikeEvent, 0, s.count)
+		oldest  = (s.next - s.count + len(s.strikes)) % len(s.strikes)
+	)
+
+	for index := 0; index < s.count; index += 1 {
+		strike := s.strikes[(oldest+index)%len(s.strikes)]
+		if !strike.Timestamp.Before(since) {
+			strikes = append(strikes, strike)
+		}
+	}
+
+	return strikes
+}
+
+// Get the average number of the recorded strikes per minute over the last 15 minutes.
+func (s *Statistics) StrikeRate() float64 {
+	return float64(len(s.RecentStrikes(statisticsRateWindow))) / statisticsRateWindow.Minutes()
+}
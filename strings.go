@@ -0,0 +1,110 @@
+package as3935go
+
+import "fmt"
+
+func (s IRQOutputSource) String() string {
+	switch s {
+	case None:
+		return "None"
+	case TRCO:
+		return "TRCO"
+	case SRCO:
+		return "SRCO"
+	case LCO:
+		return "LCO"
+	default:
+		return fmt.Sprintf("IRQOutputSource(0x%02x)", uint8(s))
+	}
+}
+
+func (t InterruptType) String() string {
+	switch t {
+	case NoResults:
+		return "NoResults"
+	case NoiseLevelTooHigh:
+		return "NoiseLevelTooHigh"
+	case DisturberDetected:
+		return "DisturberDetected"
+	case LightningInterrupt:
+		return "LightningInterrupt"
+	case InterruptUnknown:
+		return "InterruptUnknown"
+	default:
+		return fmt.Sprintf("InterruptType(0x%02x)", uint8(t))
+	}
+}
+
+func (a AnalogFrontEnd) String() string {
+	switch a {
+	case Indoor:
+		return "Indoor"
+	case Outdoor:
+		return "Outdoor"
+	default:
+		return fmt.Sprintf("AnalogFrontEnd(0x%02x)", uint8(a))
+	}
+}
+
+// The indoor and outdoor levels share the same register values, so both ratings are included.
+func (l NoiseFloorLevel) String() string {
+	if l&0x8F != 0 {
+		return fmt.Sprintf("NoiseFloorLevel(0x%02x)", uint8(l))
+	}
+
+	index := l >> 4
+	return fmt.Sprintf("NoiseFloorLevel%d (Outdoor %dµVrms, Indoor %dµVrms)", index, outdoorNoiseFloorMicroVrms[index], indoorNoiseFloorMicroVrms[index])
+}
+
+func (t WatchdogThreshold) String() string {
+	if t > WDTH10 {
+		return fmt.Sprintf("WatchdogThreshold(0x%02x)", uint8(t))
+	}
+
+	return fmt.Sprintf("WDTH%d", uint8(t))
+}
+
+func (r SpikeRejection) String() string {
+	if r > SREJ11 {
+		return fmt.Sprintf("SpikeRejection(0x%02x)", uint8(r))
+	}
+
+	return fmt.Sprintf("SREJ%d", uint8(r))
+}
+
+func (c TuningCapacitance) String() string {
+	if c > Tuning120pF {
+		return fmt.Sprintf("TuningCapacitance(0x%02x)", uint8(c))
+	}
+
+	return fmt.Sprintf("Tuning%dpF", uint8(c)*8)
+}
+
+func (n MinNumberOfLightning) String() string {
+	switch n {
+	case MinLightning1:
+		return "MinLightning1"
+	case MinLightning5:
+		return "MinLightning5"
+	case MinLightning9:
+		return "MinLightning9"
+	case MinLightning16:
+		return "MinLightning16"
+	default:
+		return fmt.Sprintf("MinNumberOfLightning(0x%02x)", uint8(n))
+	}
+}
+
+func (d FrequencyDivision) String() string {
+	switch d {
+	case FrequencyDiv16:
+		return "FrequencyDiv16"
+	case FrequencyDiv32:
+		return "FrequencyDiv32"
+	case FrequencyDiv64:
+		return "FrequencyDiv64"
+	case FrequencyDiv128:
+		return "FrequencyDiv128"
+	default:
+		return fmt.Sprintf("FrequencyDivision(0x%02x)", uint8(d))
+	}
+}
@@ -0,0 +1,74 @@
+package as3935go
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// The state snapshot is also stored on the module, so it is restored by Reconnect as well.
+func (m *module) Suspend() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshot, err := m.snapshotState()
+	if err != nil {
+		return fmt.Errorf("as3935: failed to capture the state before the suspend: %w", err)
+	}
+
+	m.snapshot = snapshot
+
+	if err := m.powerDown(); err != nil {
+		return fmt.Errorf("as3935: failed to power down the module: %w", err)
+	}
+
+	return nil
+}
+
+func (m *module) Resume() error {
+	return m.ResumeContext(context.Background())
+}
+
+// The calibration sequence resets the registers to the defaults, so the snapshot captured by Suspend is
+// restored in both cases.
+func (m *module) ResumeContext(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.calibratedAt.IsZero() || m.options.clock.Now().Sub(m.calibratedAt) >= m.options.recalibration {
+		if err := m.powerUp(ctx); err != nil {
+			return fmt.Errorf("as3935: failed to power up and calibrate the module: %w", err)
+		}
+	} else {
+		if err := m.i2c.RegWriteMasked(RegisterPower, 0x00, 0x01); err != nil {
+			return fmt.Errorf("as3935: failed to set the power up value to the register: %w", err)
+		}
+	}
+
+	if m.snapshot != nil {
+		if err := m.restoreState(m.snapshot); err != nil {
+			return fmt.Errorf("as3935: failed to restore the state after the resume: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// The interrupt handling started with Start is stopped first. The bus is closed even if powering down fails
+// or the context is already done, in which case the power down is skipped.
+func (m *module) Shutdown(ctx context.Context) error {
+	m.Stop()
+
+	var suspendErr error
+	if err := ctx.Err(); err != nil {
+		suspendErr = fmt.Errorf("as3935: the power down has been skipped: %w", err)
+	} else if err := m.Suspend(); err != nil {
+		suspendErr = err
+	}
+
+	if err := m.Close(); err != nil {
+		return errors.Join(suspendErr, err)
+	}
+
+	return suspendErr
+}
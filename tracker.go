@@ -0,0 +1,142 @@
+package as3935go
+
+import (
+	"fmt"
+	"math"
+	"sync"
+)
+
+// The direction in which the storm is moving relative to the module.
+type Trend uint8
+
+const (
+	TrendStable      Trend = 0x00
+	TrendApproaching Trend = 0x01
+	TrendReceding    Trend = 0x02
+)
+
+func (t Trend) String() string {
+	switch t {
+	case TrendStable:
+		return "Stable"
+	case TrendApproaching:
+		return "Approaching"
+	case TrendReceding:
+		return "Receding"
+	default:
+		return fmt.Sprintf("Trend(0x%02x)", uint8(t))
+	}
+}
+
+const (
+	// The number of the recent strikes used to determine the trend.
+	trackerSamples int = 8
+
+	// The weight of the newest distance in the exponentially smoothed distance.
+	trackerSmoothing float64 = 0.3
+
+	// The minimum change of the distance in kilometers per strike considered as a movement.
+	trackerSlopeThreshold float64 = 0.5
+)
+
+// Create a tracker smoothing the distance of the successive lightning strikes. The tracker is safe for
+// concurrent use and does not allocate on updates.
+func NewDistanceTracker() *DistanceTracker {
+	return &DistanceTracker{
+		mu: sync.RWMutex{},
+	}
+}
+
+// The tracker of the storm distance based on the successive lightning strikes. The distance is smoothed
+// with an exponential moving average. The trend is determined from the slopes of the distance and the
+// energy over the recent strikes: a decreasing distance is only considered approaching when the energy
+// is not decreasing and an increasing distance is only considered receding when the energy is not increasing.
+type DistanceTracker struct {
+	distances [trackerSamples]float64
+	energies  [trackerSamples]float64
+	next      int
+	count     int
+	smoothed  float64
+	mu        sync.RWMutex
+}
+
+// Update the tracker with the event. The events other than lightning and with out of range distance are ignored.
+func (t *DistanceTracker) Update(e StrikeEvent) {
+	if e.Type != LightningInterrupt || e.DistanceKm < 0 || e.DistanceKm == math.MaxInt {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	distance := float64(e.DistanceKm)
+	if t.count == 0 {
+		t.smoothed = distance
+	} else {
+		t.smoothed = trackerSmoothing*distance + (1-trackerSmoothing)*t.smoothed
+	}
+
+	t.distances[t.next] = distance
+	t.energies[t.next] = e.Energy
+	t.next = (t.next + 1) % trackerSamples
+
+	if t.count < trackerSamples {
+		t.count += 1
+	}
+}
+
+// Get the smoothed distance in kilometers, the trend and the confidence in the range from 0.0 to 1.0. The
+// confidence grows with the number of the recent strikes and is halved when the energy does not
+// corroborate the distance change. Zero values are returned if no strike has been tracked.
+func (t *DistanceTracker) Estimate() (km float64, trend Trend, confidence float64) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if t.count == 0 {
+		return 0, TrendStable, 0
+	}
+
+	confidence = float64(t.count) / float64(trackerSamples)
+	if t.count < 2 {
+		return t.smoothed, TrendStable, confidence
+	}
+
+	distanceSlope := t.slope(&t.distances)
+	energySlope := t.slope(&t.energies)
+
+	switch {
+	case distanceSlope <= -trackerSlopeThreshold && energySlope >= 0:
+		trend = TrendApproaching
+	case distanceSlope >= trackerSlopeThreshold && energySlope <= 0:
+		trend = TrendReceding
+	case math.Abs(distanceSlope) >= trackerSlopeThreshold:
+		trend = TrendStable
+		confidence /= 2
+	default:
+		trend = TrendStable
+	}
+
+	return t.smoothed, trend, confidence
+}
+
+// Calculate the least squares slope of the recent values per strike, from the oldest to the newest.
+func (t *DistanceTracker) slope(values *[trackerSamples]float64) float64 {
+	var (
+		start                = (t.next - t.count + trackerSamples) % trackerSamples
+		sumX, sumY           = 0.0, 0.0
+		sumXY, sumXX         = 0.0, 0.0
+		n            float64 = float64(t.count)
+	)
+
+	for index := 0; index < t.count; index += 1 {
+		x := float64(index)
+		y := values[(start+index)%trackerSamples]
+
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+
+	return (n*sumXY - sumX*sumY) / (n*sumXX - sumX*sumX)
+}
@@ -0,0 +1,60 @@
+package as3935go
+
+import (
+	"math"
+	"testing"
+)
+
+// Create the tracker updated with the lightning strikes at the given distances and energies.
+func trackedDistances(kms []int, energies []float64) *DistanceTracker {
+	tracker := NewDistanceTracker()
+	for index, km := range kms {
+		tracker.Update(StrikeEvent{Type: LightningInterrupt, DistanceKm: km, Energy: energies[index]})
+	}
+
+	return tracker
+}
+
+func TestDistanceTrackerEstimate(t *testing.T) {
+	cases := []struct {
+		name       string
+		kms        []int
+		energies   []float64
+		km         float64
+		trend      Trend
+		confidence float64
+	}{
+		{"approaching", []int{40, 30, 20, 10}, []float64{0.01, 0.02, 0.03, 0.04}, 25.33, TrendApproaching, 0.5},
+		{"receding", []int{10, 20, 30, 40}, []float64{0.04, 0.03, 0.02, 0.01}, 24.67, TrendReceding, 0.5},
+		{"uncorroborated", []int{40, 30, 20, 10}, []float64{0.04, 0.03, 0.02, 0.01}, 25.33, TrendStable, 0.25},
+		{"stable", []int{20, 20, 20, 20}, []float64{0.01, 0.02, 0.03, 0.04}, 20, TrendStable, 0.5},
+		{"single sample", []int{14}, []float64{0.01}, 14, TrendStable, 0.125},
+		{"wrapped samples", []int{40, 40, 40, 40, 40, 40, 40, 40, 40, 40}, make([]float64, 10), 40, TrendStable, 1},
+	}
+
+	for _, c := range cases {
+		km, trend, confidence := trackedDistances(c.kms, c.energies).Estimate()
+
+		if math.Abs(km-c.km) > 0.01 || trend != c.trend || confidence != c.confidence {
+			t.Fatalf("%s: expected %.2f km %s with %.3f confidence, got %.2f km %s with %.3f confidence",
+				c.name, c.km, c.trend, c.confidence, km, trend, confidence)
+		}
+	}
+}
+
+func TestDistanceTrackerIgnoresUnusableEvents(t *testing.T) {
+	tracker := NewDistanceTracker()
+
+	if km, trend, confidence := tracker.Estimate(); km != 0 || trend != TrendStable || confidence != 0 {
+		t.Fatalf("expected the zero estimate without the strikes, got %.2f km %s with %.3f confidence", km, trend, confidence)
+	}
+
+	tracker.Update(StrikeEvent{Type: LightningInterrupt, DistanceKm: 20})
+	tracker.Update(StrikeEvent{Type: LightningInterrupt, DistanceKm: math.MaxInt})
+	tracker.Update(StrikeEvent{Type: LightningInterrupt, DistanceKm: -1})
+	tracker.Update(StrikeEvent{Type: DisturberDetected, DistanceKm: 5})
+
+	if km, trend, confidence := tracker.Estimate(); km != 20 || trend != TrendStable || confidence != 0.125 {
+		t.Fatalf("expected only the 20 km strike to be tracked, got %.2f km %s with %.3f confidence", km, trend, confidence)
+	}
+}
@@ -0,0 +1,86 @@
+package as3935go
+
+import (
+	"fmt"
+
+	"github.com/Krzysztofz01/as3935-go/internal"
+)
+
+// The register access available inside the Transaction function. The writes are staged and the reads
+// are returning the staged values, the module registers are written only when the transaction is committed.
+type RegTx interface {
+	// Read a value from the register specified by the offset parameter.
+	RegRead(offset uint8) (uint8, error)
+
+	// Replace bits from value parameter that are specified by "1" in the mask parameter to in register specified by the offset parameter.
+	RegWriteMasked(offset, value, mask uint8) error
+}
+
+// The module lock is held for the whole transaction, so the concurrent calls are not able to observe
+// partially applied registers. A failure while committing can still leave the registers partially written.
+func (m *module) Transaction(fn func(tx RegTx) error) error {
+	if fn == nil {
+		return fmt.Errorf("as3935: invalid transaction function specified")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tx := &regTx{
+		i2c:    m.i2c,
+		staged: make(map[uint8]uint8),
+	}
+
+	if err := fn(tx); err != nil {
+		return fmt.Errorf("as3935: the transaction has been rolled back: %w", err)
+	}
+
+	if err := tx.commit(); err != nil {
+		return fmt.Errorf("as3935: failed to commit the transaction: %w", err)
+	}
+
+	return nil
+}
+
+type regTx struct {
+	i2c    internal.I2c
+	staged map[uint8]uint8
+	order  []uint8
+}
+
+func (tx *regTx) RegRead(offset uint8) (uint8, error) {
+	if offset > internal.MaxRegisterOffset {
+		return 0x00, fmt.Errorf("as3935: the offset is out of the module register range: %w", ErrValueOutOfRange)
+	}
+
+	if value, ok := tx.staged[offset]; ok {
+		return value, nil
+	}
+
+	return tx.i2c.RegRead(offset)
+}
+
+func (tx *regTx) RegWriteMasked(offset, value, mask uint8) error {
+	register, err := tx.RegRead(offset)
+	if err != nil {
+		return fmt.Errorf("as3935: failed to read the register for masked writing: %w", err)
+	}
+
+	if _, ok := tx.staged[offset]; !ok {
+		tx.order = append(tx.order, offset)
+	}
+
+	tx.staged[offset] = (register & ^mask) | (value & mask)
+	return nil
+}
+
+// Write the staged registers in the order of the first write to each register.
+func (tx *regTx) commit() error {
+	for _, offset := range tx.order {
+		if err := tx.i2c.RegWrite(offset, tx.staged[offset]); err != nil {
+			return fmt.Errorf("as3935: failed to write the staged register: %w", err)
+		}
+	}
+
+	return nil
+}
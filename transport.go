@@ -0,0 +1,76 @@
+package as3935go
+
+import (
+	"io"
+	"time"
+
+	"github.com/Krzysztofz01/as3935-go/internal"
+)
+
+// The register level communication with the AS3935 module. The default implementation is communicating
+// over the i2c bus, but a custom implementation can be provided via the NewModuleWithTransport constructor.
+// The RegRead method can be called concurrently from multiple goroutines by the module getters, while the
+// other methods are never called concurrently with any other method.
+type Transport interface {
+	// Open the connection to the module.
+	Open() error
+
+	// Close the underlying connection to the module.
+	Close() error
+
+	// Read a value from the register specified by the offset parameter.
+	RegRead(offset uint8) (uint8, error)
+
+	// Write a value byte parameter to the register specified by the offset parameter.
+	RegWrite(offset, value uint8) error
+
+	// Replace bits from value parameter that are specified by "1" in the mask parameter to in register specified by the offset parameter.
+	RegWriteMasked(offset, value, mask uint8) error
+}
+
+// Create a transport decorator retrying the register operations which failed with the ErrBusFailure error.
+// The operation is performed at most attempts times with the backoff delay between the attempts. The
+// validation errors and the Open and Close operations are not retried.
+func NewRetryTransport(transport Transport, attempts int, backoff time.Duration) Transport {
+	return internal.NewRetryI2c(transport, attempts, backoff)
+}
+
+// Build the transport used by the module operations by wrapping the underlying transport with the
+// decorators enabled by the options. The module lock must be held by the caller.
+func (m *module) decorateTransport() {
+	m.i2c = m.transport
+
+	if m.options.operationTimeout > 0 {
+		m.i2c = internal.NewTimeoutI2c(m.i2c, m.options.operationTimeout)
+	}
+
+	m.i2c = internal.NewRegisterErrorI2c(m.i2c)
+
+	if m.options.verifiedWrites {
+		m.i2c = internal.NewVerifyI2c(m.i2c)
+	}
+
+	if m.options.observer != nil {
+		m.i2c = internal.NewErrorHookI2c(m.i2c, m.observeBusError)
+	}
+
+	if m.options.retryAttempts > 1 {
+		m.i2c = internal.NewRetryI2cWithSleep(m.i2c, m.options.retryAttempts, m.options.retryBackoff, m.options.clock.Sleep)
+	}
+
+	if m.options.logger != nil {
+		m.i2c = internal.NewLoggerI2c(m.i2c, m.options.logger)
+	}
+
+	if m.options.debugOut != nil {
+		m.i2c = internal.NewDebugI2c(m.i2c, m.options.debugOut)
+	}
+}
+
+func (m *module) SetDebugOutput(debugOut io.Writer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.options.debugOut = debugOut
+	m.decorateTransport()
+}
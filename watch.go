@@ -0,0 +1,193 @@
+package as3935go
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+// The input pin connected to the IRQ pin of the module. The pin must be configured by the caller to detect
+// the rising edges. The interface is compatible with the periph.io gpio.PinIn.
+type GPIO interface {
+	// Wait for an edge on the pin up to the timeout. A negative timeout waits forever. True is returned if
+	// an edge was detected.
+	WaitForEdge(timeout time.Duration) bool
+}
+
+// The timeout of a single wait for the IRQ pin edge, which limits how long it takes to notice a done context.
+const watchEdgeTimeout = time.Duration(100) * time.Millisecond
+
+func (m *module) Watch(ctx context.Context, irqPin GPIO) (<-chan StrikeEvent, error) {
+	if irqPin == nil {
+		return nil, fmt.Errorf("as3935: invalid irq pin specified")
+	}
+
+	events := make(chan StrikeEvent)
+
+	go func() {
+		defer close(events)
+
+		m.watchIRQ(ctx, irqPin, func(event StrikeEvent) {
+			select {
+			case events <- event:
+			case <-ctx.Done():
+			}
+		}, nil)
+	}()
+
+	return events, nil
+}
+
+// Wait for the IRQ pin edges until the context is done and pass the read strike events to the onEvent
+// function. The read errors are passed to the onError function if it is not nil. The functions are
+// called without the module lock held.
+func (m *module) watchIRQ(ctx context.Context, irqPin GPIO, onEvent func(StrikeEvent), onError func(error)) {
+	for ctx.Err() == nil {
+		if !irqPin.WaitForEdge(watchEdgeTimeout) {
+			continue
+		}
+
+		event, err := m.readStrikeEvent(ctx)
+		if err != nil {
+			if onError != nil && ctx.Err() == nil {
+				onError(err)
+			}
+
+			continue
+		}
+
+		if event.Type != NoResults {
+			onEvent(event)
+		}
+	}
+}
+
+// Get the polling interval extended to the minimum polling interval of the module.
+func (m *module) pollInterval(poll time.Duration) time.Duration {
+	if poll < m.options.minPollInterval {
+		return m.options.minPollInterval
+	}
+
+	return poll
+}
+
+// Wait until the minimum polling interval elapsed since the last register read of the polling helpers.
+// The read time is reserved before the wait, so the helpers running at once are spaced out as well.
+func (m *module) awaitPollSlot(ctx context.Context) error {
+	if m.options.minPollInterval <= 0 {
+		return ctx.Err()
+	}
+
+	m.pollMu.Lock()
+	var (
+		now  = m.options.clock.Now()
+		slot = m.lastPoll.Add(m.options.minPollInterval)
+	)
+
+	if slot.Before(now) {
+		slot = now
+	}
+
+	m.lastPoll = slot
+	m.pollMu.Unlock()
+
+	return m.sleepContext(ctx, slot.Sub(now))
+}
+
+// The settle delay is awaited before every read, so the effective polling interval is the poll duration
+// extended by the settle delay.
+func (m *module) WaitForInterrupt(ctx context.Context, poll time.Duration) (InterruptType, error) {
+	if poll <= 0 {
+		return NoResults, fmt.Errorf("as3935: the polling interval must be positive: %w", ErrValueOutOfRange)
+	}
+
+	for {
+		if err := m.awaitPollSlot(ctx); err != nil {
+			return NoResults, err
+		}
+
+		interrupt, err := m.GetInterruptSourceContext(ctx)
+		if err != nil {
+			return NoResults, err
+		}
+
+		if interrupt != NoResults {
+			return interrupt, nil
+		}
+
+		if err := m.sleepContext(ctx, m.pollInterval(poll)); err != nil {
+			return NoResults, err
+		}
+	}
+}
+
+// The read errors are written to the writer and the polling continues, except for the ErrNotConnected error
+// and the writer errors, which are returned. Nil is returned when the context is done.
+func (m *module) LogInterrupts(ctx context.Context, w io.Writer, poll time.Duration) error {
+	if w == nil {
+		return fmt.Errorf("as3935: invalid interrupts log writer specified")
+	}
+
+	if poll <= 0 {
+		return fmt.Errorf("as3935: the polling interval must be positive: %w", ErrValueOutOfRange)
+	}
+
+	for {
+		if err := m.sleepContext(ctx, m.pollInterval(poll)); err != nil {
+			return nil
+		}
+
+		if err := m.awaitPollSlot(ctx); err != nil {
+			return nil
+		}
+
+		event, err := m.readStrikeEvent(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			if errors.Is(err, ErrNotConnected) {
+				return err
+			}
+
+			if _, err := fmt.Fprintf(w, "%s error: %s\n", m.options.clock.Now().Format(time.RFC3339Nano), err); err != nil {
+				return fmt.Errorf("as3935: failed to write the interrupts log: %w", err)
+			}
+
+			continue
+		}
+
+		if event.Type == NoResults {
+			continue
+		}
+
+		if err := writeInterruptLine(w, event); err != nil {
+			return fmt.Errorf("as3935: failed to write the interrupts log: %w", err)
+		}
+	}
+}
+
+// Write the strike event as a single line with the distance and energy included for the lightning.
+func writeInterruptLine(w io.Writer, event StrikeEvent) error {
+	timestamp := event.Timestamp.Format(time.RFC3339Nano)
+
+	if event.Type != LightningInterrupt {
+		_, err := fmt.Fprintf(w, "%s %s\n", timestamp, event.Type)
+		return err
+	}
+
+	distance := fmt.Sprintf("%dkm", event.DistanceKm)
+	switch event.DistanceKm {
+	case 0:
+		distance = StormOverhead.String()
+	case math.MaxInt:
+		distance = OutOfRange.String()
+	}
+
+	_, err := fmt.Fprintf(w, "%s %s distance=%s energy=%g\n", timestamp, event.Type, distance, event.Energy)
+	return err
+}
@@ -0,0 +1,58 @@
+package as3935go
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAwaitPollSlotSpacesTheReads(t *testing.T) {
+	clock := NewFakeClock(time.Unix(0, 0))
+	m := openMockModule(t, WithClock(clock), WithMinPollInterval(10*time.Millisecond))
+
+	for index := 0; index < 3; index += 1 {
+		if err := m.awaitPollSlot(context.Background()); err != nil {
+			t.Fatalf("failed to await the poll slot: %s", err)
+		}
+	}
+
+	expected := []time.Duration{10 * time.Millisecond, 10 * time.Millisecond}
+	if sleeps := clock.Sleeps(); !reflect.DeepEqual(sleeps, expected) {
+		t.Fatalf("expected the sleeps %v, got %v", expected, sleeps)
+	}
+}
+
+func TestConcurrentPollingHelpersAreSpaced(t *testing.T) {
+	const (
+		interval = 20 * time.Millisecond
+		helpers  = 4
+	)
+
+	m := openMockModule(t, WithMinPollInterval(interval), WithSettleDelay(0))
+	m.InjectEvent(StrikeEvent{Type: LightningInterrupt, DistanceKm: 10})
+
+	var (
+		wg    sync.WaitGroup
+		start = time.Now()
+	)
+
+	// NOTE: The interrupt stays pending, so every helper returns after its first read
+	for index := 0; index < helpers; index += 1 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, err := m.WaitForInterrupt(context.Background(), time.Millisecond); err != nil {
+				t.Errorf("failed to wait for the interrupt: %s", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if elapsed := time.Since(start); elapsed < (helpers-1)*interval {
+		t.Fatalf("expected the reads to be spaced by %s, the helpers took %s", interval, elapsed)
+	}
+}